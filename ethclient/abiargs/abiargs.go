@@ -0,0 +1,200 @@
+package abiargs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+/**
+JSON 参数和 ABI 类型之间的转换，不需要 abigen 生成的绑定
+    address      "0x..."
+    bool         true/false
+    string       "text"
+    bytes/bytesN "0x..."
+    intN/uintN   数字或十进制字符串，大数用字符串避免精度丢失，也接受 0x 开头的十六进制
+    T[]/T[N]     JSON 数组
+    tuple        JSON 对象(按 ABI 中的字段名)或 JSON 数组(按顺序)
+*/
+
+// Pack 把 JSON 参数转换为 ABI 类型后返回，可直接传给 abi.Pack / BoundContract
+func Pack(args abi.Arguments, raw []json.RawMessage) ([]interface{}, error) {
+	if len(raw) != len(args) {
+		return nil, fmt.Errorf("需要 %d 个参数，实际 %d 个", len(args), len(raw))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := Convert(arg.Type, raw[i])
+		if err != nil {
+			name := arg.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i)
+			}
+			return nil, fmt.Errorf("参数 %s(%s): %w", name, arg.Type.String(), err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// Convert 把一个 JSON 值转换为 t 对应的 Go 类型
+func Convert(t abi.Type, raw json.RawMessage) (interface{}, error) {
+	value, err := convert(t, raw)
+	if err != nil {
+		return nil, err
+	}
+	return value.Interface(), nil
+}
+
+func convert(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	switch t.T {
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("无效的地址 %s", raw)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, fmt.Errorf("无效的 bool %s", raw)
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, fmt.Errorf("无效的字符串 %s", raw)
+		}
+		return reflect.ValueOf(s), nil
+	case abi.BytesTy:
+		b, err := decodeHex(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+	case abi.FixedBytesTy:
+		b, err := decodeHex(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(b) > t.Size {
+			return reflect.Value{}, fmt.Errorf("bytes%d 最多 %d 字节，实际 %d 字节", t.Size, t.Size, len(b))
+		}
+		// 和 solidity 一样右侧补零
+		value := reflect.New(t.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(b))
+		return value, nil
+	case abi.IntTy, abi.UintTy:
+		return convertInt(t, raw)
+	case abi.SliceTy, abi.ArrayTy:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return reflect.Value{}, fmt.Errorf("需要 JSON 数组: %s", raw)
+		}
+		var value reflect.Value
+		if t.T == abi.ArrayTy {
+			if len(items) != t.Size {
+				return reflect.Value{}, fmt.Errorf("需要 %d 个元素，实际 %d 个", t.Size, len(items))
+			}
+			value = reflect.New(t.GetType()).Elem()
+		} else {
+			value = reflect.MakeSlice(t.GetType(), len(items), len(items))
+		}
+		for i, item := range items {
+			elem, err := convert(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("第 %d 个元素: %w", i, err)
+			}
+			value.Index(i).Set(elem)
+		}
+		return value, nil
+	case abi.TupleTy:
+		return convertTuple(t, raw)
+	}
+	return reflect.Value{}, fmt.Errorf("不支持的类型 %s", t.String())
+}
+
+// convertInt 按位数返回 int8-int64/uint8-uint64 或 *big.Int，和 abi 包的要求一致
+func convertInt(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	text := strings.Trim(strings.TrimSpace(string(raw)), `"`)
+	n, ok := new(big.Int), false
+	if strings.HasPrefix(text, "0x") || strings.HasPrefix(text, "0X") {
+		n, ok = n.SetString(text[2:], 16)
+	} else {
+		n, ok = n.SetString(text, 10)
+	}
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("无效的整数 %s", raw)
+	}
+	if !inRange(t, n) {
+		return reflect.Value{}, fmt.Errorf("%s 超出 %s 的范围", n, t.String())
+	}
+	goType := t.GetType()
+	if goType == reflect.TypeOf(&big.Int{}) {
+		return reflect.ValueOf(n), nil
+	}
+	value := reflect.New(goType).Elem()
+	if t.T == abi.UintTy {
+		value.SetUint(n.Uint64())
+	} else {
+		value.SetInt(n.Int64())
+	}
+	return value, nil
+}
+
+// inRange uintN 范围 [0, 2^N)，intN 范围 [-2^(N-1), 2^(N-1))
+func inRange(t abi.Type, n *big.Int) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	return n.Cmp(limit) < 0 && n.Cmp(new(big.Int).Neg(limit)) >= 0
+}
+
+// convertTuple tuple 可以是按 ABI 字段名的 JSON 对象，也可以是按顺序的 JSON 数组
+func convertTuple(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	items := make([]json.RawMessage, len(t.TupleElems))
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err == nil {
+		for i, name := range t.TupleRawNames {
+			item, ok := object[name]
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("缺少字段 %s", name)
+			}
+			items[i] = item
+		}
+	} else {
+		var list []json.RawMessage
+		if err := json.Unmarshal(raw, &list); err != nil || len(list) != len(items) {
+			return reflect.Value{}, fmt.Errorf("需要 JSON 对象或 %d 个元素的数组: %s", len(items), raw)
+		}
+		copy(items, list)
+	}
+	value := reflect.New(t.GetType()).Elem()
+	for i, elem := range t.TupleElems {
+		field, err := convert(*elem, items[i])
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("字段 %s: %w", t.TupleRawNames[i], err)
+		}
+		value.Field(i).Set(field)
+	}
+	return value, nil
+}
+
+func decodeHex(raw json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("需要 0x 开头的十六进制字符串: %s", raw)
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("无效的十六进制 %s: %w", s, err)
+	}
+	return b, nil
+}
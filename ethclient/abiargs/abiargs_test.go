@@ -0,0 +1,184 @@
+package abiargs
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func newType(t *testing.T, typ string, components ...abi.ArgumentMarshaling) abi.Type {
+	t.Helper()
+	parsed, err := abi.NewType(typ, "", components)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestConvert(t *testing.T) {
+	const address = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)).String()
+	minInt256 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255)).String()
+	order := []abi.ArgumentMarshaling{
+		{Name: "owner", Type: "address"},
+		{Name: "amounts", Type: "uint256[]"},
+		{Name: "memo", Type: "string"},
+	}
+
+	// want 是 Format 后的 JSON，为空时期望转换失败
+	tests := []struct {
+		typ        string
+		components []abi.ArgumentMarshaling
+		in         string
+		want       string
+	}{
+		{"uint8", nil, `255`, `"255"`},
+		{"uint8", nil, `"0xff"`, `"255"`},
+		{"uint8", nil, `256`, ``},
+		{"uint8", nil, `-1`, ``},
+		{"int8", nil, `-128`, `"-128"`},
+		{"int8", nil, `"127"`, `"127"`},
+		{"int8", nil, `128`, ``},
+		{"int8", nil, `-129`, ``},
+		{"uint64", nil, `"18446744073709551615"`, `"18446744073709551615"`},
+		{"uint64", nil, `"18446744073709551616"`, ``},
+		{"int64", nil, `"-9223372036854775808"`, `"-9223372036854775808"`},
+		{"uint256", nil, `"` + maxUint256 + `"`, `"` + maxUint256 + `"`},
+		{"uint256", nil, `"` + maxUint256 + `0"`, ``},
+		{"uint256", nil, `"0x` + strings.Repeat("f", 64) + `"`, `"` + maxUint256 + `"`},
+		{"int256", nil, `"` + minInt256 + `"`, `"` + minInt256 + `"`},
+		{"uint256", nil, `1.5`, ``},
+		{"uint256", nil, `"1e18"`, ``},
+		{"uint256", nil, `"0xzz"`, ``},
+		{"uint256", nil, `true`, ``},
+		{"address", nil, `"` + address + `"`, `"` + address + `"`},
+		{"address", nil, `"` + strings.ToLower(address) + `"`, `"` + address + `"`},
+		{"address", nil, `"` + address[2:] + `"`, `"` + address + `"`},
+		{"address", nil, `"` + address[:41] + `"`, ``},
+		{"address", nil, `"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAez"`, ``},
+		{"address", nil, `123`, ``},
+		{"bool", nil, `true`, `true`},
+		{"bool", nil, `"true"`, ``},
+		{"string", nil, `"以太坊"`, `"以太坊"`},
+		{"string", nil, `1`, ``},
+		{"bytes", nil, `"0x"`, `"0x"`},
+		{"bytes", nil, `"0x0102ff"`, `"0x0102ff"`},
+		{"bytes", nil, `"0102"`, ``},
+		{"bytes", nil, `"0x123"`, ``},
+		{"bytes", nil, `"0xgg"`, ``},
+		// bytesN 和 solidity 一样右侧补零
+		{"bytes4", nil, `"0x0102"`, `"0x01020000"`},
+		{"bytes4", nil, `"0x01020304"`, `"0x01020304"`},
+		{"bytes4", nil, `"0x0102030405"`, ``},
+		{"bytes32", nil, `"0x` + strings.Repeat("ab", 32) + `"`, `"0x` + strings.Repeat("ab", 32) + `"`},
+		{"bytes32", nil, `"0x` + strings.Repeat("ab", 33) + `"`, ``},
+		{"bytes32", nil, `"0xab"`, `"0xab` + strings.Repeat("00", 31) + `"`},
+		{"uint8[]", nil, `[]`, `[]`},
+		{"uint8[]", nil, `[1, "2", "0x03"]`, `["1","2","3"]`},
+		{"uint8[]", nil, `[1, 256]`, ``},
+		{"uint8[]", nil, `"1,2"`, ``},
+		{"address[2]", nil, `["` + address + `", "` + address + `"]`, `["` + address + `","` + address + `"]`},
+		{"address[2]", nil, `["` + address + `"]`, ``},
+		{"uint256[2][]", nil, `[[1, 2], [3, 4]]`, `[["1","2"],["3","4"]]`},
+		{"uint256[2][]", nil, `[[1, 2], [3]]`, ``},
+		{"bytes2[]", nil, `["0x01", "0x0203"]`, `["0x0100","0x0203"]`},
+		{"tuple", order, `{"owner": "` + address + `", "amounts": [1, "2"], "memo": "hi"}`,
+			`{"amounts":["1","2"],"memo":"hi","owner":"` + address + `"}`},
+		{"tuple", order, `["` + address + `", [], ""]`, `{"amounts":[],"memo":"","owner":"` + address + `"}`},
+		{"tuple", order, `{"owner": "` + address + `", "amounts": []}`, ``},
+		{"tuple", order, `["` + address + `", []]`, ``},
+		{"tuple", order, `{"owner": "0x1", "amounts": [], "memo": ""}`, ``},
+		{"tuple", order, `{"owner": "` + address + `", "amounts": [-1], "memo": ""}`, ``},
+		{"tuple[]", order, `[["` + address + `", [1], "a"], {"owner": "` + address + `", "amounts": [], "memo": "b"}]`,
+			`[{"amounts":["1"],"memo":"a","owner":"` + address + `"},{"amounts":[],"memo":"b","owner":"` + address + `"}]`},
+	}
+	for _, tt := range tests {
+		typ := newType(t, tt.typ, tt.components...)
+		value, err := Convert(typ, json.RawMessage(tt.in))
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("Convert(%s, %s) = %v, want error", tt.typ, tt.in, value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Convert(%s, %s) error: %v", tt.typ, tt.in, err)
+			continue
+		}
+		// 转换结果必须是 abi 包能直接打包的 Go 类型
+		if _, err := (abi.Arguments{{Type: typ}}).Pack(value); err != nil {
+			t.Errorf("Convert(%s, %s) = %T 无法打包: %v", tt.typ, tt.in, value, err)
+		}
+		got, err := json.Marshal(Format(typ, value))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Convert(%s, %s) = %s, want %s", tt.typ, tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestConvertIntTypes 64 位以内的整数使用对应的 Go 整数类型，更大的使用 *big.Int
+func TestConvertIntTypes(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want interface{}
+	}{
+		{"uint8", uint8(7)},
+		{"uint32", uint32(7)},
+		{"uint64", uint64(7)},
+		{"int16", int16(7)},
+		{"int64", int64(7)},
+		{"uint24", big.NewInt(7)},
+		{"uint128", big.NewInt(7)},
+		{"int256", big.NewInt(7)},
+	}
+	for _, tt := range tests {
+		got, err := Convert(newType(t, tt.typ), json.RawMessage(`"7"`))
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Convert(%s) = %#v, %v, want %#v", tt.typ, got, err, tt.want)
+		}
+	}
+}
+
+func TestPack(t *testing.T) {
+	args := abi.Arguments{
+		{Name: "to", Type: newType(t, "address")},
+		{Type: newType(t, "uint256")},
+	}
+	raw := func(values ...string) []json.RawMessage {
+		result := make([]json.RawMessage, len(values))
+		for i, v := range values {
+			result[i] = json.RawMessage(v)
+		}
+		return result
+	}
+
+	values, err := Pack(args, raw(`"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`, `"1000"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := args.Pack(values...); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		raw  []json.RawMessage
+		want string
+	}{
+		{raw(`"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`), "需要 2 个参数，实际 1 个"},
+		{raw(`"0x1"`, `1`), "参数 to(address)"},
+		// 没有名字的参数用序号
+		{raw(`"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`, `-1`), "参数 #1(uint256)"},
+	}
+	for _, tt := range tests {
+		if _, err := Pack(args, tt.raw); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Pack(%s) error = %v, want %q", tt.raw, err, tt.want)
+		}
+	}
+}
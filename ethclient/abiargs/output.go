@@ -0,0 +1,69 @@
+package abiargs
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Format 把 abi 解码出的值转换为适合 JSON 输出的形式，格式和 Convert 接受的输入一致：
+// 整数为十进制字符串，bytes/bytesN 为 0x 十六进制，tuple 为按字段名的对象
+func Format(t abi.Type, v interface{}) interface{} {
+	return format(t, reflect.ValueOf(v))
+}
+
+// FormatAll 按参数名输出，没有名字的参数用序号
+func FormatAll(args abi.Arguments, values []interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for i, arg := range args {
+		if i >= len(values) {
+			break
+		}
+		name := arg.Name
+		if name == "" {
+			name = "#" + strconv.Itoa(i)
+		}
+		result[name] = Format(arg.Type, values[i])
+	}
+	return result
+}
+
+func format(t abi.Type, v reflect.Value) interface{} {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		switch n := v.Interface().(type) {
+		case *big.Int:
+			return n.String()
+		default:
+			if t.T == abi.UintTy {
+				return new(big.Int).SetUint64(v.Uint()).String()
+			}
+			return big.NewInt(v.Int()).String()
+		}
+	case abi.AddressTy:
+		return v.Interface().(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(v.Bytes())
+	case abi.FixedBytesTy:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Encode(b)
+	case abi.SliceTy, abi.ArrayTy:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = format(*t.Elem, v.Index(i))
+		}
+		return items
+	case abi.TupleTy:
+		fields := make(map[string]interface{}, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			fields[t.TupleRawNames[i]] = format(*elem, v.Field(i))
+		}
+		return fields
+	}
+	return v.Interface()
+}
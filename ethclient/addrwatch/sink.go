@@ -0,0 +1,78 @@
+package addrwatch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Sink 告警的发送目标
+type Sink interface {
+	Send(ctx context.Context, alert *Alert) error
+}
+
+// WriterSink 每条告警写一行 JSON，通常写到标准输出
+type WriterSink struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *WriterSink) Send(ctx context.Context, alert *Alert) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.encoder.Encode(alert)
+}
+
+// WebhookSink 把告警以 JSON POST 到 URL，非 2xx 响应视为失败
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{URL: url, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *WebhookSink) Send(ctx context.Context, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 webhook 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook 返回 %s", resp.Status)
+	}
+	return nil
+}
+
+// ChanSink 把告警发送到 channel，供同一进程中的其他组件消费
+// channel 已满时阻塞，直到有空位或 ctx 结束
+type ChanSink chan *Alert
+
+func (s ChanSink) Send(ctx context.Context, alert *Alert) error {
+	select {
+	case s <- alert:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,231 @@
+package addrwatch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"ethclient/genCode"
+	"ethclient/units"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+地址余额监控
+    跟踪一组地址在每个新区块上的 ETH 和 ERC-20 余额
+    逐块计算余额变化，变化量达到阈值或余额跌破下限时产生告警
+    告警通过可替换的 Sink 发送：标准输出、webhook 或 Go channel
+*/
+
+// ETH 作为资产时的标识
+const AssetETH = "ETH"
+
+// Backend 监控需要的链上访问能力，*ethclient.Client 和 simulated 后端都满足
+type Backend interface {
+	bind.ContractCaller
+	ethereum.ChainStateReader
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Threshold 一种资产的告警条件，字段为 nil 时不检查该条件
+type Threshold struct {
+	// 单个区块内余额变化的绝对值达到 Delta 时告警
+	Delta *big.Int
+	// 余额从不低于 Below 变为低于 Below 时告警
+	Below *big.Int
+}
+
+// Kind 告警类型
+type Kind string
+
+const (
+	// 单块变化量达到阈值
+	KindDelta Kind = "delta"
+	// 余额跌破下限
+	KindBelow Kind = "below"
+)
+
+// Alert 一次余额告警，金额为最小单位(wei 或代币最小单位)
+type Alert struct {
+	Kind    Kind           `json:"kind"`
+	Block   uint64         `json:"block"`
+	Time    uint64         `json:"time"`
+	Address common.Address `json:"address"`
+	// ETH 或代币合约地址
+	Asset  string   `json:"asset"`
+	Before *big.Int `json:"before"`
+	After  *big.Int `json:"after"`
+	Delta  *big.Int `json:"delta"`
+}
+
+func (a *Alert) String() string {
+	if a.Asset == AssetETH {
+		return fmt.Sprintf("[%s] block %d %s: %s -> %s (%s)", a.Kind, a.Block, a.Address.Hex(), units.FormatEther(a.Before), units.FormatEther(a.After), units.FormatEther(a.Delta))
+	}
+	return fmt.Sprintf("[%s] block %d %s %s: %s -> %s (%+d)", a.Kind, a.Block, a.Address.Hex(), a.Asset, a.Before, a.After, a.Delta)
+}
+
+// Watcher 逐块比较地址余额并把告警发送给所有 Sink
+type Watcher struct {
+	backend   Backend
+	addresses []common.Address
+	tokens    []common.Address
+	// 资产(ETH 或代币地址)的告警条件，没有配置的资产只记录不告警
+	thresholds map[string]Threshold
+	sinks      []Sink
+
+	// 上一个已处理区块及其余额
+	last     *big.Int
+	balances map[common.Address]map[string]*big.Int
+}
+
+func NewWatcher(backend Backend, addresses, tokens []common.Address, sinks ...Sink) *Watcher {
+	return &Watcher{
+		backend:    backend,
+		addresses:  addresses,
+		tokens:     tokens,
+		thresholds: make(map[string]Threshold),
+		sinks:      sinks,
+	}
+}
+
+// SetThreshold 设置资产的告警条件，asset 为 AssetETH 或代币合约地址
+func (w *Watcher) SetThreshold(asset string, threshold Threshold) {
+	w.thresholds[assetKey(asset)] = threshold
+}
+
+// Run 按 interval 轮询新区块，逐块处理到最新区块，直到 ctx 结束
+// 第一次轮询只记录当前余额作为基准
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Poll(ctx); err != nil {
+			log.Printf("检查余额失败: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll 处理上次之后的全部新区块，返回本次产生的告警
+func (w *Watcher) Poll(ctx context.Context) ([]*Alert, error) {
+	header, err := w.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("获取最新区块失败: %w", err)
+	}
+	if w.last == nil {
+		balances, err := w.snapshot(ctx, header.Number)
+		if err != nil {
+			return nil, err
+		}
+		w.last, w.balances = header.Number, balances
+		return nil, nil
+	}
+
+	var alerts []*Alert
+	for number := new(big.Int).Add(w.last, common.Big1); number.Cmp(header.Number) <= 0; number = new(big.Int).Add(number, common.Big1) {
+		block := header
+		if number.Cmp(header.Number) != 0 {
+			if block, err = w.backend.HeaderByNumber(ctx, number); err != nil {
+				return alerts, fmt.Errorf("获取区块 %s 失败: %w", number, err)
+			}
+		}
+		balances, err := w.snapshot(ctx, number)
+		if err != nil {
+			return alerts, err
+		}
+		blockAlerts := w.compare(w.balances, balances, number.Uint64(), block.Time)
+		for _, alert := range blockAlerts {
+			w.send(ctx, alert)
+		}
+		alerts = append(alerts, blockAlerts...)
+		w.last, w.balances = number, balances
+	}
+	return alerts, nil
+}
+
+// snapshot 读取全部地址在 block 上的余额
+func (w *Watcher) snapshot(ctx context.Context, block *big.Int) (map[common.Address]map[string]*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+	balances := make(map[common.Address]map[string]*big.Int, len(w.addresses))
+	for _, address := range w.addresses {
+		assets := make(map[string]*big.Int, len(w.tokens)+1)
+		balance, err := w.backend.BalanceAt(ctx, address, block)
+		if err != nil {
+			return nil, fmt.Errorf("读取 %s 在区块 %s 的余额失败: %w", address.Hex(), block, err)
+		}
+		assets[AssetETH] = balance
+		for _, token := range w.tokens {
+			caller, err := genCode.NewIERC20Caller(token, w.backend)
+			if err != nil {
+				return nil, err
+			}
+			balance, err := caller.BalanceOf(opts, address)
+			if err != nil {
+				return nil, fmt.Errorf("读取 %s 在代币 %s 的余额失败: %w", address.Hex(), token.Hex(), err)
+			}
+			assets[token.Hex()] = balance
+		}
+		balances[address] = assets
+	}
+	return balances, nil
+}
+
+// compare 按告警条件比较相邻两个区块的余额
+func (w *Watcher) compare(before, after map[common.Address]map[string]*big.Int, block, timestamp uint64) []*Alert {
+	// 固定资产顺序，同一区块的告警顺序稳定
+	assets := []string{AssetETH}
+	for _, token := range w.tokens {
+		assets = append(assets, token.Hex())
+	}
+	var alerts []*Alert
+	for _, address := range w.addresses {
+		for _, asset := range assets {
+			previous, current := before[address][asset], after[address][asset]
+			if previous == nil || current == nil || previous.Cmp(current) == 0 {
+				continue
+			}
+			threshold, ok := w.thresholds[asset]
+			if !ok {
+				continue
+			}
+			delta := new(big.Int).Sub(current, previous)
+			alert := func(kind Kind) *Alert {
+				return &Alert{Kind: kind, Block: block, Time: timestamp, Address: address, Asset: asset, Before: previous, After: current, Delta: delta}
+			}
+			if threshold.Delta != nil && new(big.Int).Abs(delta).Cmp(threshold.Delta) >= 0 {
+				alerts = append(alerts, alert(KindDelta))
+			}
+			if threshold.Below != nil && previous.Cmp(threshold.Below) >= 0 && current.Cmp(threshold.Below) < 0 {
+				alerts = append(alerts, alert(KindBelow))
+			}
+		}
+	}
+	return alerts
+}
+
+func (w *Watcher) send(ctx context.Context, alert *Alert) {
+	for _, sink := range w.sinks {
+		if err := sink.Send(ctx, alert); err != nil {
+			log.Printf("发送告警失败: %v", err)
+		}
+	}
+}
+
+// assetKey 代币地址统一为校验和格式
+func assetKey(asset string) string {
+	if common.IsHexAddress(asset) {
+		return common.HexToAddress(asset).Hex()
+	}
+	return asset
+}
@@ -0,0 +1,335 @@
+package algocheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"ethclient/devchain"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+/**
+算法合约差分检查
+    用随机输入分别调用 solidity_task/task1 中的合约和 Go 参考实现
+    结果不一致或合约 revert 时记录输入，按输入规模统计 gas
+同一个 seed 生成的输入相同，发现问题后可以用同一个 seed 复现。
+*/
+
+// Config 差分检查参数
+type Config struct {
+	Seed int64
+	// 每个输入规模生成的随机用例数
+	Cases int
+	// 数组/字符串长度，罗马数字类算法固定按十进制位数 1-4 生成
+	Sizes []int
+}
+
+// DefaultConfig 默认配置
+func DefaultConfig() Config {
+	return Config{
+		Seed:  1,
+		Cases: 20,
+		Sizes: []int{0, 1, 2, 4, 8, 16, 32, 64, 128},
+	}
+}
+
+// Divergence 一个结果不一致的用例
+type Divergence struct {
+	Algorithm string `json:"algorithm"`
+	Size      int    `json:"size"`
+	// 输入参数的 JSON
+	Input string `json:"input"`
+	Want  string `json:"want"`
+	Got   string `json:"got,omitempty"`
+	// 合约调用失败的原因
+	Error string `json:"error,omitempty"`
+}
+
+// GasStat 某个算法在一个输入规模下的 gas，包括 21000 的交易基础费用和 calldata 费用
+type GasStat struct {
+	Algorithm string `json:"algorithm"`
+	Size      int    `json:"size"`
+	Samples   int    `json:"samples"`
+	Min       uint64 `json:"min"`
+	Max       uint64 `json:"max"`
+	Avg       uint64 `json:"avg"`
+}
+
+// Report 一次差分检查的结果
+type Report struct {
+	Seed        int64        `json:"seed"`
+	Cases       int          `json:"cases"`
+	Skipped     []string     `json:"skipped,omitempty"`
+	Divergences []Divergence `json:"divergences"`
+	Gas         []GasStat    `json:"gas"`
+}
+
+// algorithm 一个算法合约和对应的参考实现
+type algorithm struct {
+	// 合约名，Deploy 指定目录时读取其中的 <contract>.bin
+	contract string
+	method   string
+	metaData *bind.MetaData
+	// 固定的输入规模，为空时使用 Config.Sizes
+	sizes []int
+	// gen 生成规模为 size 的输入，返回合约方法的参数
+	gen func(r *rand.Rand, size int) []interface{}
+	// reference 用参考实现计算期望的返回值，类型和 abigen 绑定的返回值一致
+	reference func(args []interface{}) interface{}
+}
+
+var romanSizes = []int{1, 2, 3, 4}
+
+var algorithms = []algorithm{
+	{
+		contract: "RomanToInt",
+		method:   "romanToInt",
+		metaData: genCode.RomanToIntMetaData,
+		sizes:    romanSizes,
+		gen: func(r *rand.Rand, size int) []interface{} {
+			return []interface{}{IntToRoman(randomDigits(r, size))}
+		},
+		reference: func(args []interface{}) interface{} {
+			return big.NewInt(int64(RomanToInt(args[0].(string))))
+		},
+	},
+	{
+		contract: "IntToRoman",
+		method:   "intToRoman",
+		metaData: genCode.IntToRomanMetaData,
+		sizes:    romanSizes,
+		gen: func(r *rand.Rand, size int) []interface{} {
+			return []interface{}{big.NewInt(int64(randomDigits(r, size)))}
+		},
+		reference: func(args []interface{}) interface{} {
+			return IntToRoman(int(args[0].(*big.Int).Int64()))
+		},
+	},
+	{
+		contract: "BinarySearch",
+		method:   "binarySearch",
+		metaData: genCode.BinarySearchMetaData,
+		gen: func(r *rand.Rand, size int) []interface{} {
+			nums := randomSorted(r, size)
+			// 一半的用例查找存在的元素，另一半查找任意值(包括小于最小值和大于最大值)
+			target := r.Intn(1000)
+			if size > 0 && r.Intn(2) == 0 {
+				target = nums[r.Intn(size)]
+			}
+			return []interface{}{toBig(nums), big.NewInt(int64(target))}
+		},
+		reference: func(args []interface{}) interface{} {
+			return BinarySearch(fromBig(args[0].([]*big.Int)), int(args[1].(*big.Int).Int64()))
+		},
+	},
+	{
+		contract: "MergeSortedArray",
+		method:   "mergeSortedArray",
+		metaData: genCode.MergeSortedArrayMetaData,
+		gen: func(r *rand.Rand, size int) []interface{} {
+			// 两个数组的总长度为 size
+			n := 0
+			if size > 0 {
+				n = r.Intn(size + 1)
+			}
+			return []interface{}{toBig(randomSorted(r, n)), toBig(randomSorted(r, size-n))}
+		},
+		reference: func(args []interface{}) interface{} {
+			return toBig(MergeSortedArray(fromBig(args[0].([]*big.Int)), fromBig(args[1].([]*big.Int))))
+		},
+	},
+	{
+		contract: "ReserseString",
+		method:   "reverseString",
+		metaData: genCode.ReserseStringMetaData,
+		gen: func(r *rand.Rand, size int) []interface{} {
+			return []interface{}{randomString(r, size)}
+		},
+		reference: func(args []interface{}) interface{} {
+			return ReverseString(args[0].(string))
+		},
+	},
+}
+
+// Deploy 把算法合约部署到内存链上，binDir 为空时使用 genCode 绑定中的字节码
+// 否则从 binDir 读取 <合约名>.bin，没有字节码文件的合约跳过
+func Deploy(ctx context.Context, chain *devchain.Chain, binDir string) (map[string]common.Address, error) {
+	deployed := make(map[string]common.Address)
+	for _, algo := range algorithms {
+		code := common.FromHex(algo.metaData.Bin)
+		if binDir != "" {
+			path := filepath.Join(binDir, algo.contract+".bin")
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				log.Printf("没有 %s，跳过 %s", path, algo.contract)
+				continue
+			}
+			var err error
+			if code, err = devchain.ReadBin(path); err != nil {
+				return nil, err
+			}
+		}
+		parsed, err := algo.metaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		address, _, err := chain.Deploy(ctx, chain.Accounts[0], parsed, code)
+		if err != nil {
+			return nil, fmt.Errorf("部署 %s 失败: %w", algo.contract, err)
+		}
+		deployed[algo.contract] = address
+	}
+	return deployed, nil
+}
+
+// Run 对 deployed 中的每个合约执行差分检查，deployed 的 key 是合约名
+func Run(ctx context.Context, backend bind.ContractBackend, deployed map[string]common.Address, cfg Config) (*Report, error) {
+	report := &Report{Seed: cfg.Seed, Cases: cfg.Cases, Divergences: []Divergence{}}
+	for _, algo := range algorithms {
+		// 每个算法单独的随机源，跳过某个合约不影响其他合约的输入
+		r := rand.New(rand.NewSource(cfg.Seed))
+		address, ok := deployed[algo.contract]
+		if !ok {
+			report.Skipped = append(report.Skipped, algo.contract)
+			continue
+		}
+		parsed, err := algo.metaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		contract := bind.NewBoundContract(address, *parsed, backend, backend, backend)
+
+		sizes := algo.sizes
+		if len(sizes) == 0 {
+			sizes = cfg.Sizes
+		}
+		for _, size := range sizes {
+			stat := GasStat{Algorithm: algo.contract, Size: size}
+			var total uint64
+			for i := 0; i < cfg.Cases; i++ {
+				args := algo.gen(r, size)
+				divergence, gas, err := compare(ctx, backend, contract, parsed, address, algo, args)
+				if err != nil {
+					return nil, err
+				}
+				if divergence != nil {
+					divergence.Size = size
+					report.Divergences = append(report.Divergences, *divergence)
+					continue
+				}
+				if stat.Samples == 0 || gas < stat.Min {
+					stat.Min = gas
+				}
+				if gas > stat.Max {
+					stat.Max = gas
+				}
+				total += gas
+				stat.Samples++
+			}
+			if stat.Samples > 0 {
+				stat.Avg = total / uint64(stat.Samples)
+			}
+			report.Gas = append(report.Gas, stat)
+		}
+	}
+	return report, nil
+}
+
+// compare 调用合约和参考实现，结果一致时返回合约调用的 gas
+func compare(ctx context.Context, backend bind.ContractBackend, contract *bind.BoundContract, parsed *abi.ABI,
+	address common.Address, algo algorithm, args []interface{}) (*Divergence, uint64, error) {
+	input, err := json.Marshal(args)
+	if err != nil {
+		return nil, 0, err
+	}
+	want := format(algo.reference(args))
+
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &out, algo.method, args...); err != nil {
+		// 输入越界、下溢等都会导致合约 revert，参考实现没有失败的情况
+		return &Divergence{Algorithm: algo.contract, Input: string(input), Want: want, Error: err.Error()}, 0, nil
+	}
+	if got := format(out[0]); got != want {
+		return &Divergence{Algorithm: algo.contract, Input: string(input), Want: want, Got: got}, 0, nil
+	}
+
+	data, err := parsed.Pack(algo.method, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	gas, err := backend.EstimateGas(ctx, ethereum.CallMsg{To: &address, Data: data})
+	if err != nil {
+		return nil, 0, fmt.Errorf("估算 %s gas 失败: %w", algo.method, err)
+	}
+	return nil, gas, nil
+}
+
+// format 把返回值格式化后比较，字符串加引号以便看出空白和不可见字符
+func format(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// randomDigits 生成 digits 位的十进制数，最大 3999
+func randomDigits(r *rand.Rand, digits int) int {
+	low := 1
+	for i := 1; i < digits; i++ {
+		low *= 10
+	}
+	high := low*10 - 1
+	if high > 3999 {
+		high = 3999
+	}
+	return low + r.Intn(high-low+1)
+}
+
+// randomSorted 生成长度为 n 的升序数组，可能有重复元素
+func randomSorted(r *rand.Rand, n int) []int {
+	nums := make([]int, n)
+	for i := range nums {
+		nums[i] = r.Intn(1000)
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// 随机字符串的字符集，包含多字节的 UTF-8 字符
+var alphabet = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 以太坊")
+
+// randomString 生成 n 个字符的字符串，其中少量是多字节字符
+func randomString(r *rand.Rand, n int) string {
+	runes := make([]rune, n)
+	for i := range runes {
+		runes[i] = alphabet[r.Intn(len(alphabet))]
+	}
+	return string(runes)
+}
+
+func toBig(nums []int) []*big.Int {
+	result := make([]*big.Int, len(nums))
+	for i, n := range nums {
+		result[i] = big.NewInt(int64(n))
+	}
+	return result
+}
+
+func fromBig(nums []*big.Int) []int {
+	result := make([]int, len(nums))
+	for i, n := range nums {
+		result[i] = int(n.Int64())
+	}
+	return result
+}
@@ -0,0 +1,193 @@
+package algocheck
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+	"unicode/utf8"
+
+	"ethclient/devchain"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// knownDivergence 合约中已知的缺陷，match 的输入上合约和参考实现必然不一致
+type knownDivergence struct {
+	reason  string
+	match   func(args []interface{}) bool
+	example []interface{}
+}
+
+// knownDivergences 模糊测试跳过落在已知缺陷范围内的输入，其余输入仍然要求完全一致
+// TestKnownDivergences 检查这些缺陷仍然存在，修复合约后需要删除对应的条目
+var knownDivergences = map[string]knownDivergence{
+	"BinarySearch": {
+		reason: "空数组或目标小于最小值时 right = mid - 1 下溢，合约 revert",
+		match: func(args []interface{}) bool {
+			nums := args[0].([]*big.Int)
+			return len(nums) == 0 || args[1].(*big.Int).Cmp(nums[0]) < 0
+		},
+		example: []interface{}{toBig([]int{3, 5}), big.NewInt(1)},
+	},
+	"MergeSortedArray": {
+		reason: "取第二个数组的元素时写成了 arr1[b]",
+		match: func(args []interface{}) bool {
+			// 第一个数组中有元素大于第二个数组的最小值时才会走到这个分支
+			a, b := args[0].([]*big.Int), args[1].([]*big.Int)
+			return len(a) > 0 && len(b) > 0 && a[len(a)-1].Cmp(b[0]) > 0
+		},
+		example: []interface{}{toBig([]int{1, 3, 5}), toBig([]int{1, 2, 6})},
+	},
+	"ReserseString": {
+		reason: "按字节反转，多字节的 UTF-8 字符被拆开",
+		match: func(args []interface{}) bool {
+			s := args[0].(string)
+			return utf8.RuneCountInString(s) != len(s)
+		},
+		example: []interface{}{"以太坊abc"},
+	},
+}
+
+// deployAlgorithm 在内存链上部署 genCode 绑定中 contract 的字节码，返回对比合约和参考实现的函数
+// 不访问任何真实的链
+func deployAlgorithm(tb testing.TB, contract string) func(args ...interface{}) (*Divergence, error) {
+	tb.Helper()
+	var algo *algorithm
+	for i := range algorithms {
+		if algorithms[i].contract == contract {
+			algo = &algorithms[i]
+		}
+	}
+	if algo == nil {
+		tb.Fatalf("未知的合约 %s", contract)
+	}
+
+	parsed, err := algo.metaData.GetAbi()
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	chain, err := devchain.New(1)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	address, _, err := chain.Deploy(ctx, chain.Accounts[0], parsed, common.FromHex(algo.metaData.Bin))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	bound := bind.NewBoundContract(address, *parsed, chain.Client, chain.Client, chain.Client)
+
+	return func(args ...interface{}) (*Divergence, error) {
+		divergence, _, err := compare(ctx, chain.Client, bound, parsed, address, *algo, args)
+		return divergence, err
+	}
+}
+
+// fuzzTarget 返回的函数在结果不一致时测试失败，已知缺陷范围内的输入跳过
+func fuzzTarget(f *testing.F, contract string) func(t *testing.T, args ...interface{}) {
+	run := deployAlgorithm(f, contract)
+	known, hasKnown := knownDivergences[contract]
+
+	return func(t *testing.T, args ...interface{}) {
+		if hasKnown && known.match(args) {
+			t.Skip(known.reason)
+		}
+		divergence, err := run(args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if divergence != nil {
+			t.Fatalf("%s 结果不一致: input=%s want=%s got=%s err=%s",
+				contract, divergence.Input, divergence.Want, divergence.Got, divergence.Error)
+		}
+	}
+}
+
+func TestKnownDivergences(t *testing.T) {
+	for contract, known := range knownDivergences {
+		t.Run(contract, func(t *testing.T) {
+			if !known.match(known.example) {
+				t.Fatalf("示例输入不在已知缺陷范围内: %v", known.example)
+			}
+			divergence, err := deployAlgorithm(t, contract)(known.example...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if divergence == nil {
+				t.Fatalf("%s 的缺陷已经不存在(%s)，需要从 knownDivergences 中删除", contract, known.reason)
+			}
+		})
+	}
+}
+
+// sortedFromBytes 把模糊测试生成的字节转换为升序数组
+func sortedFromBytes(data []byte) []*big.Int {
+	nums := make([]int, len(data))
+	for i, b := range data {
+		nums[i] = int(b)
+	}
+	sort.Ints(nums)
+	return toBig(nums)
+}
+
+func FuzzRomanToInt(f *testing.F) {
+	check := fuzzTarget(f, "RomanToInt")
+	for _, n := range []uint16{1, 4, 9, 14, 40, 90, 400, 1994, 3999} {
+		f.Add(n)
+	}
+	f.Fuzz(func(t *testing.T, n uint16) {
+		// 合约只接受 1-3999 对应的合法罗马数字
+		check(t, IntToRoman(int(n)%3999+1))
+	})
+}
+
+func FuzzIntToRoman(f *testing.F) {
+	check := fuzzTarget(f, "IntToRoman")
+	for _, n := range []uint16{1, 4, 9, 58, 1994, 3999} {
+		f.Add(n)
+	}
+	f.Fuzz(func(t *testing.T, n uint16) {
+		check(t, big.NewInt(int64(n)%3999+1))
+	})
+}
+
+func FuzzBinarySearch(f *testing.F) {
+	check := fuzzTarget(f, "BinarySearch")
+	f.Add([]byte{}, byte(0))
+	f.Add([]byte{1}, byte(1))
+	f.Add([]byte{1, 3, 5, 7}, byte(4))
+	f.Add([]byte{2, 2, 2}, byte(2))
+	f.Add([]byte{0, 255}, byte(255))
+	f.Fuzz(func(t *testing.T, data []byte, target byte) {
+		check(t, sortedFromBytes(data), big.NewInt(int64(target)))
+	})
+}
+
+func FuzzMergeSortedArray(f *testing.F) {
+	check := fuzzTarget(f, "MergeSortedArray")
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte{1, 2, 3}, []byte{})
+	f.Add([]byte{}, []byte{4, 5})
+	f.Add([]byte{1, 3, 5}, []byte{1, 2, 6})
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		check(t, sortedFromBytes(a), sortedFromBytes(b))
+	})
+}
+
+func FuzzReverseString(f *testing.F) {
+	check := fuzzTarget(f, "ReserseString")
+	f.Add("")
+	f.Add("a")
+	f.Add("hello world")
+	f.Add("以太坊abc")
+	f.Fuzz(func(t *testing.T, s string) {
+		// 参考实现按字符反转，非法 UTF-8 没有确定的期望结果
+		if !utf8.ValidString(s) {
+			t.Skip()
+		}
+		check(t, s)
+	})
+}
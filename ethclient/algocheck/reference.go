@@ -0,0 +1,78 @@
+package algocheck
+
+// solidity_task/task1 中算法合约的 Go 参考实现，差分检查时作为期望结果
+
+var (
+	romanValues  = []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	romanSymbols = []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	romanDigits  = map[byte]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+)
+
+// leetcode 13 罗马数字转整数
+func RomanToInt(s string) int {
+	result := 0
+	for i := 0; i < len(s); i++ {
+		value := romanDigits[s[i]]
+		if i+1 < len(s) && value < romanDigits[s[i+1]] {
+			result -= value
+		} else {
+			result += value
+		}
+	}
+	return result
+}
+
+// leetcode 12 整数转罗马数字，num 范围 1-3999
+func IntToRoman(num int) string {
+	result := ""
+	for i, value := range romanValues {
+		for num >= value {
+			num -= value
+			result += romanSymbols[i]
+		}
+	}
+	return result
+}
+
+// leetcode 704 二分查找，nums 升序
+func BinarySearch(nums []int, target int) bool {
+	left, right := 0, len(nums)-1
+	for left <= right {
+		mid := left + (right-left)/2
+		switch {
+		case nums[mid] == target:
+			return true
+		case nums[mid] > target:
+			right = mid - 1
+		default:
+			left = mid + 1
+		}
+	}
+	return false
+}
+
+// leetcode 88 合并两个有序数组，返回新数组
+func MergeSortedArray(nums1, nums2 []int) []int {
+	result := make([]int, 0, len(nums1)+len(nums2))
+	i, j := 0, 0
+	for i < len(nums1) && j < len(nums2) {
+		if nums1[i] <= nums2[j] {
+			result = append(result, nums1[i])
+			i++
+		} else {
+			result = append(result, nums2[j])
+			j++
+		}
+	}
+	result = append(result, nums1[i:]...)
+	return append(result, nums2[j:]...)
+}
+
+// leetcode 344 反转字符串，按字符(rune)反转，多字节的 UTF-8 字符保持完整
+func ReverseString(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
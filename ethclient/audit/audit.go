@@ -0,0 +1,384 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+/**
+拍卖资金/所有权审计
+遍历 AuctionFactory 创建的所有 Auction 合约，找出以下问题：
+    已过期但从未调用 endAuction 的拍卖
+    过期后 NFT 仍锁在拍卖合约中
+    跨链获胜者已结算但 NFT 未转出（transferNFTToCrossChainWinner 未调用）
+    拍卖已结束但合约里仍有余额
+    NFT 从未存入拍卖合约（单独报告，无法通过 endAuction 修复）
+并为每个可修复的问题生成对应的交易。
+*/
+
+// Backend 审计需要的链上访问能力，*ethclient.Client 和 simulated 后端都满足
+type Backend interface {
+	bind.ContractBackend
+	ethereum.ChainStateReader
+}
+
+// Kind 问题类型
+type Kind string
+
+const (
+	// 已过期但未结束
+	KindNotEnded Kind = "expired-not-ended"
+	// 过期后 NFT 仍在拍卖合约中
+	KindNFTHeld Kind = "nft-held"
+	// 跨链获胜者未拿到 NFT
+	KindUnpaidCrossChainWinner Kind = "unpaid-cross-chain-winner"
+	// 拍卖已结束但合约仍有 ETH 或 ERC20 余额，合约没有提取路径
+	KindStrandedFunds Kind = "stranded-funds"
+	// NFT 从未存入拍卖合约，endAuction 转出 NFT 时必然失败
+	KindNotDeposited Kind = "nft-not-deposited"
+)
+
+// AuctionState 某个区块上一个拍卖合约的快照
+type AuctionState struct {
+	Address     common.Address `json:"address"`
+	NftOwner    common.Address `json:"nftOwner"`
+	NftContract common.Address `json:"nftContract"`
+	TokenID     *big.Int       `json:"tokenId"`
+	NftHolder   common.Address `json:"nftHolder"`
+	// NFT 是否转入过拍卖合约
+	Deposited bool `json:"deposited"`
+	// NFT 是否由拍卖合约转出过
+	Released            bool           `json:"released"`
+	EndTime             uint64         `json:"endTime"`
+	Expired             bool           `json:"expired"`
+	HighestBidder       common.Address `json:"highestBidder"`
+	HighestPaymentToken common.Address `json:"highestPaymentToken"`
+	HighestTokenAmount  *big.Int       `json:"highestTokenAmount"`
+	CrossChainWinner    bool           `json:"crossChainWinner"`
+	WinningBidID        common.Hash    `json:"winningBidId"`
+	WinnerSourceChain   uint64         `json:"winnerSourceChain"`
+	WinnerMarked        bool           `json:"winnerMarked"`
+	CcipAdapter         common.Address `json:"ccipAdapter"`
+	ERC20Token          common.Address `json:"erc20Token"`
+	ETHBalance          *big.Int       `json:"ethBalance"`
+	ERC20Balance        *big.Int       `json:"erc20Balance"`
+}
+
+// Ended 是否已调用过 endAuction
+// 合约没有 ended 标记：跨链获胜时 endAuction 会把 crossChainBids[id].isWinner 置为 true，
+// 其余情况下 endAuction 是唯一会把 NFT 转出合约的函数，以拍卖合约发出的 Transfer 事件为准。
+// 不能用当前持有者判断，NFT 从未存入时持有者同样不是拍卖合约。
+func (s *AuctionState) Ended() bool {
+	if s.CrossChainWinner {
+		return s.WinnerMarked
+	}
+	return s.Released
+}
+
+// Resolution 修复问题需要发送的交易，From 是合约要求的调用者
+type Resolution struct {
+	Kind   Kind           `json:"kind"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Method string         `json:"method"`
+	Data   hexutil.Bytes  `json:"data"`
+	Gas    uint64         `json:"gas,omitempty"`
+	// 预估 gas 失败时的错误，说明该交易当前无法成功
+	SimErr string `json:"simErr,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+// Finding 一个拍卖的审计结果
+type Finding struct {
+	State  *AuctionState `json:"state"`
+	Issues []Kind        `json:"issues"`
+	Fixes  []Resolution  `json:"fixes"`
+}
+
+// Report 审计报告
+type Report struct {
+	Factory  common.Address `json:"factory"`
+	Block    uint64         `json:"block"`
+	Time     uint64         `json:"time"`
+	Auctions int            `json:"auctions"`
+	Findings []*Finding     `json:"findings"`
+}
+
+type Auditor struct {
+	backend Backend
+	factory common.Address
+}
+
+func NewAuditor(backend Backend, factory common.Address) *Auditor {
+	return &Auditor{
+		backend: backend,
+		factory: factory,
+	}
+}
+
+// Run 在最新区块上审计工厂下的全部拍卖，只返回有问题的拍卖
+func (a *Auditor) Run(ctx context.Context) (*Report, error) {
+	header, err := a.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("获取最新区块失败: %w", err)
+	}
+	callOpts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+
+	factory, err := genCode.NewAuctionFactoryCaller(a.factory, a.backend)
+	if err != nil {
+		return nil, err
+	}
+	auctions, err := factory.GetAuctions(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("读取拍卖列表失败: %w", err)
+	}
+
+	report := &Report{
+		Factory:  a.factory,
+		Block:    header.Number.Uint64(),
+		Time:     header.Time,
+		Auctions: len(auctions),
+	}
+	for _, addr := range auctions {
+		state, err := a.Inspect(callOpts, addr, header.Time)
+		if err != nil {
+			return nil, fmt.Errorf("读取拍卖 %s 失败: %w", addr.Hex(), err)
+		}
+		finding := a.check(ctx, state)
+		if len(finding.Issues) > 0 {
+			report.Findings = append(report.Findings, finding)
+		}
+	}
+	return report, nil
+}
+
+// Inspect 读取单个拍卖合约的状态，now 为判断是否过期使用的区块时间，callOpts.BlockNumber 不能为空
+func (a *Auditor) Inspect(callOpts *bind.CallOpts, addr common.Address, now uint64) (*AuctionState, error) {
+	auction, err := genCode.NewAuctionCaller(addr, a.backend)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &AuctionState{Address: addr}
+	status, err := auction.GetAuctionStatus(callOpts)
+	if err != nil {
+		return nil, err
+	}
+	// expirationTime 实际是拍卖持续时长
+	end := new(big.Int).Add(status.StartTime, status.ExpirationTime)
+	state.EndTime = end.Uint64()
+	state.Expired = now >= state.EndTime
+	state.HighestBidder = status.HighestBidder
+
+	if state.NftOwner, err = auction.NftOwner(callOpts); err != nil {
+		return nil, err
+	}
+	if state.NftContract, err = auction.NftContract(callOpts); err != nil {
+		return nil, err
+	}
+	if state.TokenID, err = auction.TokenId(callOpts); err != nil {
+		return nil, err
+	}
+	if state.HighestPaymentToken, err = auction.HighestPaymentToken(callOpts); err != nil {
+		return nil, err
+	}
+	if state.HighestTokenAmount, err = auction.HighestTokenAmount(callOpts); err != nil {
+		return nil, err
+	}
+	if state.CcipAdapter, err = auction.CcipAdapter(callOpts); err != nil {
+		return nil, err
+	}
+	if state.ERC20Token, err = auction.ERC20TOKEN(callOpts); err != nil {
+		return nil, err
+	}
+
+	crossChain, bidID, err := auction.GetCrossChainWinnerInfo(callOpts)
+	if err != nil {
+		return nil, err
+	}
+	state.CrossChainWinner = crossChain
+	state.WinningBidID = common.Hash(bidID)
+	if crossChain {
+		bid, err := auction.CrossChainBids(callOpts, bidID)
+		if err != nil {
+			return nil, err
+		}
+		state.WinnerSourceChain = bid.SourceChain
+		state.WinnerMarked = bid.IsWinner
+	}
+
+	nft, err := genCode.NewNftTokenCaller(state.NftContract, a.backend)
+	if err != nil {
+		return nil, err
+	}
+	if state.NftHolder, err = nft.OwnerOf(callOpts, state.TokenID); err != nil {
+		return nil, fmt.Errorf("查询 NFT 持有者失败: %w", err)
+	}
+
+	// 用 Transfer 事件判断 NFT 是否存入过、是否已转出，从拍卖创建的区块查到 callOpts 对应的区块
+	lastBlock := callOpts.BlockNumber.Uint64()
+	firstBlock, err := a.creationBlock(callOpts.Context, status.StartTime, lastBlock)
+	if err != nil {
+		return nil, fmt.Errorf("查询拍卖创建区块失败: %w", err)
+	}
+	filterOpts := &bind.FilterOpts{Context: callOpts.Context, Start: firstBlock, End: &lastBlock}
+	if state.Deposited, state.Released, err = a.transfers(filterOpts, state.NftContract, addr, state.TokenID); err != nil {
+		return nil, fmt.Errorf("查询 NFT 转移事件失败: %w", err)
+	}
+
+	if state.ETHBalance, err = a.backend.BalanceAt(callOpts.Context, addr, callOpts.BlockNumber); err != nil {
+		return nil, err
+	}
+	erc20, err := genCode.NewIERC20Caller(state.ERC20Token, a.backend)
+	if err != nil {
+		return nil, err
+	}
+	if state.ERC20Balance, err = erc20.BalanceOf(callOpts, addr); err != nil {
+		return nil, fmt.Errorf("查询 ERC20 余额失败: %w", err)
+	}
+	return state, nil
+}
+
+// check 根据快照判断问题并生成修复交易
+func (a *Auditor) check(ctx context.Context, state *AuctionState) *Finding {
+	finding := &Finding{State: state}
+
+	// NFT 从未存入时 endAuction 无法成功，不能按未结束处理，也没有可以生成的修复交易
+	if !state.Deposited {
+		finding.Issues = append(finding.Issues, KindNotDeposited)
+		if state.Expired && (state.ETHBalance.Sign() > 0 || state.ERC20Balance.Sign() > 0) {
+			finding.Issues = append(finding.Issues, KindStrandedFunds)
+		}
+		return finding
+	}
+	if !state.Expired {
+		return finding
+	}
+
+	ended := state.Ended()
+	holdsNFT := state.NftHolder == state.Address
+	if !ended {
+		finding.Issues = append(finding.Issues, KindNotEnded)
+	}
+	if holdsNFT {
+		finding.Issues = append(finding.Issues, KindNFTHeld)
+	}
+
+	switch {
+	case !ended:
+		// endAuction 同时会转出 NFT 和最高出价
+		finding.Fixes = append(finding.Fixes, a.resolve(ctx, Resolution{
+			Kind: KindNotEnded,
+			From: state.NftOwner,
+			To:   state.Address,
+		}, "endAuction"))
+	case state.CrossChainWinner && holdsNFT:
+		finding.Issues = append(finding.Issues, KindUnpaidCrossChainWinner)
+		fix := a.resolve(ctx, Resolution{
+			Kind: KindUnpaidCrossChainWinner,
+			From: state.CcipAdapter,
+			To:   state.Address,
+			Note: "只能由 ccipAdapter 调用，需要适配器合约转发",
+		}, "transferNFTToCrossChainWinner", state.HighestBidder, state.WinnerSourceChain)
+		finding.Fixes = append(finding.Fixes, fix)
+	}
+
+	// 结束后合约不应再持有资金，未结束时资金会在 endAuction 里结算
+	if ended && (state.ETHBalance.Sign() > 0 || state.ERC20Balance.Sign() > 0) {
+		finding.Issues = append(finding.Issues, KindStrandedFunds)
+	}
+	return finding
+}
+
+// creationBlock 拍卖合约创建区块的下界，last 以后不再查找
+// 构造函数把创建区块的 block.timestamp 记为 startTime，区块时间单调不减，
+// 第一个时间不早于 startTime 的区块不会晚于创建区块
+func (a *Auditor) creationBlock(ctx context.Context, startTime *big.Int, last uint64) (uint64, error) {
+	var searchErr error
+	n := sort.Search(int(last)+1, func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		header, err := a.backend.HeaderByNumber(ctx, big.NewInt(int64(i)))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return new(big.Int).SetUint64(header.Time).Cmp(startTime) >= 0
+	})
+	return uint64(n), searchErr
+}
+
+// transfers 查询一次 tokenID 的 Transfer 事件，返回 NFT 是否转入过、是否由 auction 转出过
+func (a *Auditor) transfers(opts *bind.FilterOpts, nftContract, auction common.Address, tokenID *big.Int) (deposited, released bool, err error) {
+	filterer, err := genCode.NewNftTokenFilterer(nftContract, a.backend)
+	if err != nil {
+		return false, false, err
+	}
+	it, err := filterer.FilterTransfer(opts, nil, nil, []*big.Int{tokenID})
+	if err != nil {
+		return false, false, err
+	}
+	defer it.Close()
+	for it.Next() {
+		if it.Event.To == auction {
+			deposited = true
+		}
+		if it.Event.From == auction {
+			released = true
+		}
+	}
+	return deposited, released, it.Error()
+}
+
+// resolve 打包调用数据，并以要求的调用者身份预估 gas
+func (a *Auditor) resolve(ctx context.Context, fix Resolution, method string, args ...interface{}) Resolution {
+	parsed, err := genCode.AuctionMetaData.GetAbi()
+	if err != nil {
+		fix.SimErr = err.Error()
+		return fix
+	}
+	fix.Method = method
+	if fix.Data, err = parsed.Pack(method, args...); err != nil {
+		fix.SimErr = err.Error()
+		return fix
+	}
+
+	to := fix.To
+	gas, err := a.backend.EstimateGas(ctx, ethereum.CallMsg{
+		From: fix.From,
+		To:   &to,
+		Data: fix.Data,
+	})
+	if err != nil {
+		fix.SimErr = err.Error()
+		return fix
+	}
+	fix.Gas = gas
+	return fix
+}
+
+// Send 用 opts 对应的账户发送修复交易，opts.From 必须是 fix.From
+func Send(opts *bind.TransactOpts, backend bind.ContractBackend, fix Resolution) (common.Hash, error) {
+	if opts.From != fix.From {
+		return common.Hash{}, fmt.Errorf("交易需要由 %s 发送", fix.From.Hex())
+	}
+	parsed, err := genCode.AuctionMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	contract := bind.NewBoundContract(fix.To, *parsed, backend, backend, backend)
+	tx, err := contract.RawTransact(opts, fix.Data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
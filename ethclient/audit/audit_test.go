@@ -0,0 +1,245 @@
+package audit
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"ethclient/devchain"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// testdata 中的 Auction 由 nft_market-main 的 Auction.sol 编译，OpenZeppelin 和 Chainlink 依赖
+// 换成 testdata/contracts 中只包含用到接口的替身；AuditFixtures.sol 提供 NFT、ERC20 和代替工厂的拍卖列表
+//go:generate solcjs --abi --bin --base-path ../../nft_market-main/contracts --include-path testdata/contracts -o testdata ../../nft_market-main/contracts/Auction.sol testdata/contracts/AuditFixtures.sol
+
+// auditChain 内存链上的 NFT、ERC20 和拍卖列表合约，Accounts[0] 是所有拍卖的卖家
+type auditChain struct {
+	t       *testing.T
+	ctx     context.Context
+	chain   *devchain.Chain
+	seller  *bind.TransactOpts
+	auction *abi.ABI
+	nft     *bind.BoundContract
+	erc20   *bind.BoundContract
+	factory *bind.BoundContract
+
+	nftAddr, erc20Addr, factoryAddr common.Address
+	// 拍卖地址到创建区块
+	created map[common.Address]uint64
+}
+
+func newAuditChain(t *testing.T) *auditChain {
+	t.Helper()
+	chain, err := devchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	auction, err := genCode.AuctionMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &auditChain{
+		t:       t,
+		ctx:     context.Background(),
+		chain:   chain,
+		seller:  chain.Accounts[0],
+		auction: auction,
+		created: make(map[common.Address]uint64),
+	}
+	c.nftAddr, c.nft = c.deployFixture("TestNFT")
+	c.erc20Addr, c.erc20 = c.deployFixture("TestERC20")
+	c.factoryAddr, c.factory = c.deployFixture("TestFactory")
+	return c
+}
+
+func (c *auditChain) deployFixture(name string) (common.Address, *bind.BoundContract) {
+	c.t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "AuditFixtures_sol_"+name+".abi"))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer f.Close()
+	parsed, err := abi.JSON(f)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	code, err := devchain.ReadBin(filepath.Join("testdata", "AuditFixtures_sol_"+name+".bin"))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	address, _, err := c.chain.Deploy(c.ctx, c.seller, &parsed, code)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return address, bind.NewBoundContract(address, parsed, c.chain.Client, c.chain.Client, c.chain.Client)
+}
+
+// transact 由卖家调用合约方法并出块，交易失败时测试失败
+func (c *auditChain) transact(contract *bind.BoundContract, method string, args ...interface{}) {
+	c.t.Helper()
+	tx, err := contract.Transact(c.seller, method, args...)
+	if err != nil {
+		c.t.Fatalf("%s: %v", method, err)
+	}
+	c.chain.Commit()
+	receipt, err := bind.WaitMined(c.ctx, c.chain.Client, tx)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		c.t.Fatalf("%s 执行失败", method)
+	}
+}
+
+// newAuction 给卖家铸造 tokenID 并创建持续 duration 秒的拍卖，deposit 为 false 时 NFT 不转入拍卖合约
+func (c *auditChain) newAuction(tokenID int64, duration int64, deposit bool) (common.Address, *bind.BoundContract) {
+	c.t.Helper()
+	id := big.NewInt(tokenID)
+	c.transact(c.nft, "mint", c.seller.From, id)
+
+	code, err := devchain.ReadBin(filepath.Join("testdata", "Auction_sol_Auction.bin"))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	// 测试中没有出价，价格预言机只需要非零地址
+	address, receipt, err := c.chain.Deploy(c.ctx, c.seller, c.auction, code,
+		c.erc20Addr, c.seller.From, c.nftAddr, id, big.NewInt(1), big.NewInt(1), big.NewInt(duration), c.factoryAddr)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.created[address] = receipt.BlockNumber.Uint64()
+	if deposit {
+		c.transact(c.nft, "transferFrom", c.seller.From, address, id)
+	}
+	c.transact(c.factory, "addAuction", address)
+	return address, bind.NewBoundContract(address, *c.auction, c.chain.Client, c.chain.Client, c.chain.Client)
+}
+
+func (c *auditChain) run() (*Report, map[common.Address]*Finding) {
+	c.t.Helper()
+	report, err := NewAuditor(c.chain.Client, c.factoryAddr).Run(c.ctx)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	findings := make(map[common.Address]*Finding)
+	for _, finding := range report.Findings {
+		findings[finding.State.Address] = finding
+	}
+	return report, findings
+}
+
+func TestRun(t *testing.T) {
+	c := newAuditChain(t)
+	ended, endedAuction := c.newAuction(1, 60, true)
+	stranded, strandedAuction := c.newAuction(2, 60, true)
+	pending, _ := c.newAuction(3, 60, true)
+	undeposited, _ := c.newAuction(4, 60, false)
+	if err := c.chain.AdjustTime(2 * time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// 没有出价时 endAuction 把 NFT 还给卖家
+	c.transact(endedAuction, "endAuction")
+	c.transact(strandedAuction, "endAuction")
+	// 结束后转入的 ERC20 没有提取路径
+	c.transact(c.erc20, "mint", stranded, big.NewInt(100))
+	running, _ := c.newAuction(5, 3600, true)
+
+	report, findings := c.run()
+	if report.Auctions != 5 || len(findings) != 3 {
+		t.Fatalf("Auctions = %d, findings = %d, want 5, 3", report.Auctions, len(findings))
+	}
+	for _, addr := range []common.Address{ended, running} {
+		if finding, ok := findings[addr]; ok {
+			t.Errorf("%s 不应有问题: %v", addr.Hex(), finding.Issues)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		address common.Address
+		issues  []Kind
+		fixes   []string
+	}{
+		{"结束后有余额", stranded, []Kind{KindStrandedFunds}, nil},
+		{"过期未结束", pending, []Kind{KindNotEnded, KindNFTHeld}, []string{"endAuction"}},
+		{"NFT 未存入", undeposited, []Kind{KindNotDeposited}, nil},
+	}
+	for _, tt := range tests {
+		finding := findings[tt.address]
+		if finding == nil {
+			t.Errorf("%s: 没有审计结果", tt.name)
+			continue
+		}
+		if !slices.Equal(finding.Issues, tt.issues) {
+			t.Errorf("%s: Issues = %v, want %v", tt.name, finding.Issues, tt.issues)
+		}
+		var methods []string
+		for _, fix := range finding.Fixes {
+			if fix.SimErr != "" || fix.Gas == 0 {
+				t.Errorf("%s: %s 预估失败: %s", tt.name, fix.Method, fix.SimErr)
+			}
+			methods = append(methods, fix.Method)
+		}
+		if !slices.Equal(methods, tt.fixes) {
+			t.Errorf("%s: Fixes = %v, want %v", tt.name, methods, tt.fixes)
+		}
+	}
+	if state := findings[undeposited].State; state.Deposited || state.Released || state.NftHolder != c.seller.From {
+		t.Errorf("NFT 未存入: Deposited = %v, Released = %v, NftHolder = %s", state.Deposited, state.Released, state.NftHolder.Hex())
+	}
+	if state := findings[stranded].State; !state.Deposited || !state.Released || state.ERC20Balance.Int64() != 100 {
+		t.Errorf("结束后有余额: Deposited = %v, Released = %v, ERC20Balance = %s", state.Deposited, state.Released, state.ERC20Balance)
+	}
+
+	// 发送修复交易后过期未结束的拍卖不再报告
+	fix := findings[pending].Fixes[0]
+	if _, err := Send(c.chain.Accounts[0], c.chain.Client, fix); err != nil {
+		t.Fatal(err)
+	}
+	c.chain.Commit()
+	if _, findings = c.run(); len(findings) != 2 || findings[pending] != nil {
+		t.Fatalf("修复后 findings = %d, pending = %v", len(findings), findings[pending])
+	}
+}
+
+// TestCreationBlock 按 startTime 找到的区块就是拍卖合约的创建区块
+func TestCreationBlock(t *testing.T) {
+	c := newAuditChain(t)
+	for i := int64(1); i <= 3; i++ {
+		c.newAuction(i, 60, true)
+		if err := c.chain.AdjustTime(time.Duration(i) * time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+	header, err := c.chain.Client.HeaderByNumber(c.ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditor := NewAuditor(c.chain.Client, c.factoryAddr)
+	for addr, want := range c.created {
+		auction, err := genCode.NewAuctionCaller(addr, c.chain.Client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		status, err := auction.GetAuctionStatus(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := auditor.creationBlock(c.ctx, status.StartTime, header.Number.Uint64())
+		if err != nil || got != want {
+			t.Errorf("creationBlock(%s) = %d, %v, want %d", addr.Hex(), got, err, want)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: MIT
+// 审计测试用的替身，只保留 PriceOracle 用到的 Chainlink AggregatorV3Interface 接口
+pragma solidity ^0.8.0;
+
+interface AggregatorV3Interface {
+    function latestRoundData()
+        external
+        view
+        returns (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound);
+}
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: MIT
+// 审计测试用的替身，只保留 Auction 用到的 OpenZeppelin IERC20 接口
+pragma solidity ^0.8.20;
+
+interface IERC20 {
+    function balanceOf(address account) external view returns (uint256);
+
+    function transfer(address to, uint256 value) external returns (bool);
+
+    function transferFrom(address from, address to, uint256 value) external returns (bool);
+}
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: MIT
+// 审计测试用的替身，只保留 Auction 用到的 OpenZeppelin IERC721 接口
+pragma solidity ^0.8.20;
+
+interface IERC721 {
+    event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
+
+    function ownerOf(uint256 tokenId) external view returns (address owner);
+
+    function transferFrom(address from, address to, uint256 tokenId) external;
+}
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: MIT
+// 审计测试用的替身，与 OpenZeppelin IERC721Receiver 接口一致
+pragma solidity ^0.8.20;
+
+interface IERC721Receiver {
+    function onERC721Received(
+        address operator,
+        address from,
+        uint256 tokenId,
+        bytes calldata data
+    ) external returns (bytes4);
+}
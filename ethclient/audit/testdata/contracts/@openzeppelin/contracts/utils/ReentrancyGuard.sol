@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+// 审计测试用的替身，行为和错误定义与 OpenZeppelin 5.x 的 ReentrancyGuard 一致
+pragma solidity ^0.8.20;
+
+abstract contract ReentrancyGuard {
+    uint256 private constant NOT_ENTERED = 1;
+    uint256 private constant ENTERED = 2;
+
+    uint256 private _status;
+
+    error ReentrancyGuardReentrantCall();
+
+    constructor() {
+        _status = NOT_ENTERED;
+    }
+
+    modifier nonReentrant() {
+        if (_status == ENTERED) {
+            revert ReentrancyGuardReentrantCall();
+        }
+        _status = ENTERED;
+        _;
+        _status = NOT_ENTERED;
+    }
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.27;
+
+// 审计测试用的最小 ERC721，audit 只依赖 ownerOf 和 Transfer 事件
+contract TestNFT {
+    event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
+
+    mapping(uint256 => address) public ownerOf;
+
+    function mint(address to, uint256 tokenId) external {
+        require(ownerOf[tokenId] == address(0), "minted");
+        ownerOf[tokenId] = to;
+        emit Transfer(address(0), to, tokenId);
+    }
+
+    // 测试中只有持有者自己转出，不实现授权
+    function transferFrom(address from, address to, uint256 tokenId) external {
+        require(ownerOf[tokenId] == from && msg.sender == from, "not owner");
+        ownerOf[tokenId] = to;
+        emit Transfer(from, to, tokenId);
+    }
+}
+
+// 审计测试用的最小 ERC20
+contract TestERC20 {
+    mapping(address => uint256) public balanceOf;
+
+    function mint(address to, uint256 value) external {
+        balanceOf[to] += value;
+    }
+
+    function transfer(address to, uint256 value) external returns (bool) {
+        balanceOf[msg.sender] -= value;
+        balanceOf[to] += value;
+        return true;
+    }
+
+    function transferFrom(address from, address to, uint256 value) external returns (bool) {
+        balanceOf[from] -= value;
+        balanceOf[to] += value;
+        return true;
+    }
+}
+
+// 代替 AuctionFactory 记录拍卖列表，真实工厂依赖可升级合约库
+contract TestFactory {
+    address[] private auctions;
+
+    function addAuction(address auction) external {
+        auctions.push(auction);
+    }
+
+    function getAuctions() external view returns (address[] memory) {
+        return auctions;
+    }
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"ethclient/dyncall"
+	"ethclient/units"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	abiFileFlag = &cli.StringFlag{
+		Name:     "abi",
+		Usage:    "合约 ABI 文件，如 Store_sol_Store.abi",
+		Required: true,
+	}
+	abiContractFlag = &cli.StringFlag{
+		Name:     "contract",
+		Usage:    "合约地址",
+		Required: true,
+	}
+)
+
+var abiCommand = &cli.Command{
+	Name:  "abi",
+	Usage: "按 ABI 文件调用任意合约，不需要生成绑定；参数为 JSON，地址等字符串可以不加引号",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "call",
+			Usage:     "调用方法并输出返回值",
+			ArgsUsage: "<method> [args...]",
+			Flags: []cli.Flag{
+				abiFileFlag,
+				abiContractFlag,
+				&cli.StringFlag{
+					Name:  "from",
+					Usage: "调用者地址",
+				},
+				&cli.Uint64Flag{
+					Name:  "block",
+					Usage: "读取指定区块的状态，默认最新区块",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				if c.NArg() < 1 {
+					return fmt.Errorf("需要方法名")
+				}
+				client, contract, err := abiContract(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				var from common.Address
+				if c.String("from") != "" {
+					if from, err = addressFlag(c, "from"); err != nil {
+						return err
+					}
+				}
+				var block *big.Int
+				if c.IsSet("block") {
+					block = new(big.Int).SetUint64(c.Uint64("block"))
+				}
+				result, err := contract.Call(c.Context, from, c.Args().First(), jsonArgs(c.Args().Tail()), block)
+				if err != nil {
+					return err
+				}
+				return printJSON(result)
+			},
+		},
+		{
+			Name:      "send",
+			Usage:     "发送交易，需要 --key",
+			ArgsUsage: "<method> [args...]",
+			Flags: []cli.Flag{
+				abiFileFlag,
+				abiContractFlag,
+				&cli.StringFlag{
+					Name:  "value",
+					Usage: "随交易发送的 ETH，如 0.1ether、20gwei，没有单位时为 wei",
+				},
+				&cli.BoolFlag{
+					Name:  "wait",
+					Usage: "等待交易上链并输出解码后的事件",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				if c.NArg() < 1 {
+					return fmt.Errorf("需要方法名")
+				}
+				client, contract, err := abiContract(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				opts, err := transactor(c, client)
+				if err != nil {
+					return err
+				}
+				if c.String("value") != "" {
+					value, err := units.ParseEther(c.String("value"))
+					if err != nil {
+						return err
+					}
+					opts.Value = value
+				}
+				tx, err := contract.Transact(opts, c.Args().First(), jsonArgs(c.Args().Tail()))
+				if err != nil {
+					return err
+				}
+				fmt.Printf("tx sent: %s\n", tx.Hash().Hex())
+				if !c.Bool("wait") {
+					return nil
+				}
+
+				receipt, err := bind.WaitMined(c.Context, client, tx)
+				if err != nil {
+					return err
+				}
+				events := []*dyncall.Event{}
+				for _, l := range receipt.Logs {
+					if l.Address != contract.Address {
+						continue
+					}
+					event, err := contract.DecodeLog(*l)
+					if err != nil {
+						return err
+					}
+					if event != nil {
+						events = append(events, event)
+					}
+				}
+				return printJSON(map[string]interface{}{
+					"status":  receipt.Status,
+					"block":   receipt.BlockNumber,
+					"gasUsed": receipt.GasUsed,
+					"events":  events,
+				})
+			},
+		},
+		{
+			Name:  "events",
+			Usage: "查询并解码合约事件",
+			Flags: []cli.Flag{
+				abiFileFlag,
+				abiContractFlag,
+				&cli.StringFlag{
+					Name:  "event",
+					Usage: "只查询该事件，默认全部",
+				},
+				&cli.Uint64Flag{
+					Name:  "from-block",
+					Usage: "开始区块",
+				},
+				&cli.Uint64Flag{
+					Name:  "to-block",
+					Usage: "结束区块，默认最新区块",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				client, contract, err := abiContract(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				var to *big.Int
+				if c.IsSet("to-block") {
+					to = new(big.Int).SetUint64(c.Uint64("to-block"))
+				}
+				events, err := contract.Events(c.Context, c.String("event"), c.Uint64("from-block"), to)
+				if err != nil {
+					return err
+				}
+				return printJSON(events)
+			},
+		},
+	},
+}
+
+// abiContract 连接节点并按 --abi 绑定 --contract 指定的合约
+func abiContract(c *cli.Context) (*ethclient.Client, *dyncall.Contract, error) {
+	address, err := addressFlag(c, abiContractFlag.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	parsed, err := dyncall.LoadABI(c.String(abiFileFlag.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := dial(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, dyncall.NewContract(address, parsed, client), nil
+}
+
+// jsonArgs 命令行参数不是合法 JSON 时按字符串处理，地址和十六进制不需要加引号
+func jsonArgs(args []string) []json.RawMessage {
+	raw := make([]json.RawMessage, len(args))
+	for i, arg := range args {
+		if json.Valid([]byte(arg)) {
+			raw[i] = json.RawMessage(arg)
+			continue
+		}
+		quoted, _ := json.Marshal(arg)
+		raw[i] = quoted
+	}
+	return raw
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"ethclient/addrwatch"
+	"ethclient/units"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+var addrWatchCommand = &cli.Command{
+	Name:  "addr-watch",
+	Usage: "逐块监控地址的 ETH 和 ERC-20 余额变化，达到阈值时告警",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "address",
+			Usage:    "监控的地址，可以指定多次",
+			Required: true,
+		},
+		&cli.StringSliceFlag{
+			Name:  "token",
+			Usage: "同时监控的 ERC-20 合约地址，可以指定多次",
+		},
+		&cli.StringFlag{
+			Name:  "eth-delta",
+			Usage: "单块 ETH 变化量告警阈值，如 0.5ether，没有单位时为 wei",
+		},
+		&cli.StringFlag{
+			Name:  "eth-below",
+			Usage: "ETH 余额跌破该值时告警，如 1ether，没有单位时为 wei",
+		},
+		&cli.StringFlag{
+			Name:  "token-delta",
+			Usage: "单块代币变化量告警阈值(代币最小单位)，对所有 --token 生效",
+		},
+		&cli.StringFlag{
+			Name:  "token-below",
+			Usage: "代币余额跌破该值时告警(代币最小单位)，对所有 --token 生效",
+		},
+		&cli.StringFlag{
+			Name:  "webhook",
+			Usage: "告警同时 POST 到该 URL",
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "轮询新区块的间隔",
+			Value: defaultPollInterval,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		addresses, err := hexAddresses(c, "address")
+		if err != nil {
+			return err
+		}
+		tokens, err := hexAddresses(c, "token")
+		if err != nil {
+			return err
+		}
+		ethThreshold, err := thresholdFlags(c, "eth-delta", "eth-below", units.ParseEther)
+		if err != nil {
+			return err
+		}
+		tokenThreshold, err := thresholdFlags(c, "token-delta", "token-below", func(s string) (*big.Int, error) {
+			return units.ParseUnits(s, 0)
+		})
+		if err != nil {
+			return err
+		}
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		sinks := []addrwatch.Sink{addrwatch.NewWriterSink(os.Stdout)}
+		if c.String("webhook") != "" {
+			sinks = append(sinks, addrwatch.NewWebhookSink(c.String("webhook")))
+		}
+		watcher := addrwatch.NewWatcher(client, addresses, tokens, sinks...)
+		watcher.SetThreshold(addrwatch.AssetETH, ethThreshold)
+		for _, token := range tokens {
+			watcher.SetThreshold(token.Hex(), tokenThreshold)
+		}
+		return watcher.Run(c.Context, c.Duration("interval"))
+	},
+}
+
+// hexAddresses 读取并校验可以指定多次的地址参数
+func hexAddresses(c *cli.Context, name string) ([]common.Address, error) {
+	var addresses []common.Address
+	for _, value := range c.StringSlice(name) {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("无效的 --%s 地址: %s", name, value)
+		}
+		addresses = append(addresses, common.HexToAddress(value))
+	}
+	return addresses, nil
+}
+
+// thresholdFlags 读取一种资产的告警阈值，两个都没有指定时变化量阈值为 1，即任何变化都告警
+func thresholdFlags(c *cli.Context, delta, below string, parse func(string) (*big.Int, error)) (addrwatch.Threshold, error) {
+	var threshold addrwatch.Threshold
+	for name, target := range map[string]**big.Int{delta: &threshold.Delta, below: &threshold.Below} {
+		if c.String(name) == "" {
+			continue
+		}
+		value, err := parse(c.String(name))
+		if err != nil || value.Sign() < 0 {
+			return threshold, fmt.Errorf("无效的 --%s: %s", name, c.String(name))
+		}
+		*target = value
+	}
+	if threshold.Delta == nil && threshold.Below == nil {
+		threshold.Delta = big.NewInt(1)
+	}
+	return threshold, nil
+}
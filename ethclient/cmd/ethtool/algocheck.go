@@ -0,0 +1,54 @@
+package main
+
+import (
+	"ethclient/algocheck"
+	"ethclient/devchain"
+	"github.com/urfave/cli/v2"
+)
+
+var algoCheckCommand = &cli.Command{
+	Name:  "algo-check",
+	Usage: "在内存链上用随机输入对比 solidity_task/task1 算法合约和 Go 参考实现，并按输入规模统计 gas",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "bin-dir",
+			Usage: "solc --bin -o 的输出目录，读取其中的 <合约名>.bin，为空时使用 genCode 绑定中的字节码",
+		},
+		&cli.Int64Flag{
+			Name:  "seed",
+			Usage: "随机输入的种子，相同的种子生成相同的输入",
+			Value: algocheck.DefaultConfig().Seed,
+		},
+		&cli.IntFlag{
+			Name:  "cases",
+			Usage: "每个输入规模的随机用例数",
+			Value: algocheck.DefaultConfig().Cases,
+		},
+		&cli.IntSliceFlag{
+			Name:  "sizes",
+			Usage: "数组/字符串长度",
+			Value: cli.NewIntSlice(algocheck.DefaultConfig().Sizes...),
+		},
+	},
+	Action: func(c *cli.Context) error {
+		chain, err := devchain.New(1)
+		if err != nil {
+			return err
+		}
+		defer chain.Close()
+
+		deployed, err := algocheck.Deploy(c.Context, chain, c.String("bin-dir"))
+		if err != nil {
+			return err
+		}
+		report, err := algocheck.Run(c.Context, chain.Client, deployed, algocheck.Config{
+			Seed:  c.Int64("seed"),
+			Cases: c.Int("cases"),
+			Sizes: c.IntSlice("sizes"),
+		})
+		if err != nil {
+			return err
+		}
+		return printJSON(report)
+	},
+}
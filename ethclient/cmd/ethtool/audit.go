@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"ethclient/audit"
+	"github.com/urfave/cli/v2"
+)
+
+var auditCommand = &cli.Command{
+	Name:  "audit",
+	Usage: "审计工厂下所有拍卖：未结束、NFT 滞留、跨链获胜者未结算",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "factory",
+			Usage:    "AuctionFactory 合约地址",
+			Required: true,
+		},
+		&cli.BoolFlag{
+			Name:  "send",
+			Usage: "用 --key 对应账户发送其有权限发送的修复交易",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		factory, err := addressFlag(c, "factory")
+		if err != nil {
+			return err
+		}
+
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		report, err := audit.NewAuditor(client, factory).Run(c.Context)
+		if err != nil {
+			return err
+		}
+		if err := printJSON(report); err != nil {
+			return err
+		}
+		if !c.Bool("send") {
+			return nil
+		}
+
+		opts, err := transactor(c, client)
+		if err != nil {
+			return err
+		}
+		for _, finding := range report.Findings {
+			for _, fix := range finding.Fixes {
+				if fix.From != opts.From {
+					continue
+				}
+				if fix.SimErr != "" {
+					fmt.Fprintf(os.Stderr, "跳过 %s.%s: %s\n", fix.To.Hex(), fix.Method, fix.SimErr)
+					continue
+				}
+				hash, err := audit.Send(opts, client, fix)
+				if err != nil {
+					return fmt.Errorf("发送 %s.%s 失败: %w", fix.To.Hex(), fix.Method, err)
+				}
+				fmt.Fprintf(os.Stderr, "tx sent: %s (%s.%s)\n", hash.Hex(), fix.To.Hex(), fix.Method)
+			}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+
+	"ethclient/txdecode"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli/v2"
+)
+
+var abiDirFlag = &cli.StringSliceFlag{
+	Name:  "abi-dir",
+	Usage: "额外加载其中 .abi 文件的目录，可以指定多次；genCode 中的绑定总会加载",
+}
+
+var decodeCommand = &cli.Command{
+	Name:  "decode",
+	Usage: "按仓库中已知的 ABI 解码交易 calldata 和 revert 原因",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "tx",
+			Usage:     "解码交易的方法、参数，失败的交易重放后解码 revert 原因",
+			ArgsUsage: "<tx hash>",
+			Flags:     []cli.Flag{abiDirFlag},
+			Action: func(c *cli.Context) error {
+				if c.NArg() != 1 {
+					return fmt.Errorf("需要交易哈希")
+				}
+				registry, err := decodeRegistry(c)
+				if err != nil {
+					return err
+				}
+				client, err := dial(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				report, err := registry.DecodeTx(c.Context, client, common.HexToHash(c.Args().First()))
+				if err != nil {
+					return err
+				}
+				return printJSON(report)
+			},
+		},
+		{
+			Name:      "calldata",
+			Usage:     "解码十六进制 calldata",
+			ArgsUsage: "<0x...>",
+			Flags:     []cli.Flag{abiDirFlag},
+			Action: func(c *cli.Context) error {
+				data, err := hexArg(c)
+				if err != nil {
+					return err
+				}
+				registry, err := decodeRegistry(c)
+				if err != nil {
+					return err
+				}
+				call, err := registry.DecodeCalldata(data)
+				if err != nil {
+					return err
+				}
+				return printJSON(call)
+			},
+		},
+		{
+			Name:      "revert",
+			Usage:     "解码十六进制 revert 数据",
+			ArgsUsage: "<0x...>",
+			Flags:     []cli.Flag{abiDirFlag},
+			Action: func(c *cli.Context) error {
+				data, err := hexArg(c)
+				if err != nil {
+					return err
+				}
+				registry, err := decodeRegistry(c)
+				if err != nil {
+					return err
+				}
+				return printJSON(registry.DecodeRevert(data))
+			},
+		},
+	},
+}
+
+// decodeRegistry 加载 genCode 绑定和 --abi-dir 中的 ABI
+func decodeRegistry(c *cli.Context) (*txdecode.Registry, error) {
+	registry, err := txdecode.DefaultRegistry()
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range c.StringSlice(abiDirFlag.Name) {
+		if err := registry.AddDir(dir); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func hexArg(c *cli.Context) ([]byte, error) {
+	if c.NArg() != 1 {
+		return nil, fmt.Errorf("需要一个 0x 开头的十六进制参数")
+	}
+	data, err := hexutil.Decode(c.Args().First())
+	if err != nil {
+		return nil, fmt.Errorf("无效的十六进制: %v", err)
+	}
+	return data, nil
+}
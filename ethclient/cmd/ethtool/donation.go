@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"ethclient/donation"
+	"ethclient/units"
+	"github.com/urfave/cli/v2"
+)
+
+var donationCommand = &cli.Command{
+	Name:  "donation",
+	Usage: "记录 BeggingContract 捐赠、维护排行榜，余额达到阈值时自动提取",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "contract",
+			Usage:    "BeggingContract 合约地址",
+			Required: true,
+		},
+		&cli.Uint64Flag{
+			Name:  "from-block",
+			Usage: "开始记录的区块(合约部署区块)",
+		},
+		&cli.StringFlag{
+			Name:  "threshold",
+			Usage: "自动提取的余额阈值，如 1ether，没有单位时为 wei；为空时不自动提取，需要 owner 的 --key",
+		},
+		&cli.BoolFlag{
+			Name:  "legacy",
+			Usage: "合约没有 Donation 事件时从 donate 交易还原捐赠",
+		},
+		&cli.StringFlag{
+			Name:  "listen",
+			Usage: "排行榜 HTTP 监听地址",
+			Value: ":8081",
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "轮询新区块的间隔",
+			Value: defaultPollInterval,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		contract, err := addressFlag(c, "contract")
+		if err != nil {
+			return err
+		}
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		tracker, err := donation.NewTracker(contract, client, c.Uint64("from-block"))
+		if err != nil {
+			return err
+		}
+		if c.Bool("legacy") {
+			tracker.FromTransactions(client)
+		}
+
+		var withdrawer *donation.AutoWithdrawer
+		if c.String("threshold") != "" {
+			threshold, err := units.ParseEther(c.String("threshold"))
+			if err != nil {
+				return fmt.Errorf("无效的阈值: %w", err)
+			}
+			opts, err := transactor(c, client)
+			if err != nil {
+				return err
+			}
+			if withdrawer, err = donation.NewAutoWithdrawer(contract, client, opts, threshold); err != nil {
+				return err
+			}
+		}
+
+		service := donation.NewService(client, tracker, withdrawer)
+		go service.Run(c.Context, c.Duration("interval"))
+
+		log.Printf("donation listening on %s", c.String("listen"))
+		return http.ListenAndServe(c.String("listen"), service.Handler())
+	},
+}
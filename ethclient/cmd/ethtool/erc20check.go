@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"ethclient/devchain"
+	"ethclient/erc20check"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/urfave/cli/v2"
+)
+
+var erc20CheckCommand = &cli.Command{
+	Name:  "erc20-check",
+	Usage: "在内存链上部署 ERC-20 合约并按 EIP-20 逐项检查，输出所有不一致的地方",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "bin",
+			Usage:    "合约创建字节码文件(solc --bin 的输出)",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "kind",
+			Usage: "合约类型: standard 或 erc201(solidity_task/task2/Erc201.sol)",
+			Value: "standard",
+		},
+		&cli.StringFlag{
+			Name:  "abi",
+			Usage: "standard 合约实际的 ABI 文件，为空时按 IERC20 检查；构造函数参数需要已拼接在字节码后",
+		},
+		&cli.StringFlag{
+			Name:  "name",
+			Usage: "erc201 构造函数的 name_",
+			Value: "Erc201",
+		},
+		&cli.StringFlag{
+			Name:  "symbol",
+			Usage: "erc201 构造函数的 symbol_",
+			Value: "E201",
+		},
+		&cli.BoolFlag{
+			Name:  "all",
+			Usage: "输出全部检查结果，默认只输出不一致的项",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		code, err := devchain.ReadBin(c.String("bin"))
+		if err != nil {
+			return err
+		}
+		chain, err := devchain.New(3)
+		if err != nil {
+			return err
+		}
+		defer chain.Close()
+		env := erc20check.NewSimulated(chain)
+
+		var token erc20check.Token
+		switch c.String("kind") {
+		case "erc201":
+			parsed, err := genCode.Erc201MetaData.GetAbi()
+			if err != nil {
+				return err
+			}
+			address, _, err := chain.Deploy(c.Context, env.Owner, parsed, code, c.String("name"), c.String("symbol"))
+			if err != nil {
+				return err
+			}
+			if token, err = erc20check.NewErc201(address, env.Backend); err != nil {
+				return err
+			}
+		case "standard":
+			parsed, err := genCode.IERC20MetaData.GetAbi()
+			if err != nil {
+				return err
+			}
+			var actual *abi.ABI
+			if c.String("abi") != "" {
+				file, err := os.Open(c.String("abi"))
+				if err != nil {
+					return err
+				}
+				loaded, err := abi.JSON(file)
+				file.Close()
+				if err != nil {
+					return fmt.Errorf("解析 ABI 失败: %w", err)
+				}
+				actual = &loaded
+			}
+			address, _, err := chain.Deploy(c.Context, env.Owner, parsed, code)
+			if err != nil {
+				return err
+			}
+			if token, err = erc20check.NewStandard(address, env.Backend, actual); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("未知的合约类型: %s", c.String("kind"))
+		}
+
+		report, err := erc20check.Run(c.Context, env, token)
+		if err != nil {
+			return err
+		}
+		if !c.Bool("all") {
+			report.Results = report.Deviations()
+		}
+		return printJSON(report)
+	},
+}
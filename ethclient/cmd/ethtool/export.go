@@ -0,0 +1,78 @@
+package main
+
+import (
+	"ethclient/export"
+	"github.com/urfave/cli/v2"
+)
+
+var exportCommand = &cli.Command{
+	Name:  "export",
+	Usage: "把区块范围内的区块、交易、回执和日志导出为按区块分区的 CSV / Parquet 文件",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "out",
+			Usage:    "输出目录，重复执行时跳过已完成的分区",
+			Required: true,
+		},
+		&cli.Uint64Flag{
+			Name:  "from",
+			Usage: "起始区块",
+		},
+		&cli.Uint64Flag{
+			Name:  "to",
+			Usage: "结束区块(包含)，默认最新区块",
+		},
+		&cli.StringSliceFlag{
+			Name:  "format",
+			Usage: "输出格式 csv、parquet，可以指定多次",
+			Value: cli.NewStringSlice(string(export.FormatCSV), string(export.FormatParquet)),
+		},
+		&cli.Uint64Flag{
+			Name:  "partition",
+			Usage: "每个分区的区块数",
+			Value: export.DefaultPartitionSize,
+		},
+		&cli.IntFlag{
+			Name:  "workers",
+			Usage: "并发获取区块的数量",
+			Value: export.DefaultWorkers,
+		},
+		abiDirFlag,
+	},
+	Action: func(c *cli.Context) error {
+		var formats []export.Format
+		for _, value := range c.StringSlice("format") {
+			format, err := export.ParseFormat(value)
+			if err != nil {
+				return err
+			}
+			formats = append(formats, format)
+		}
+		registry, err := decodeRegistry(c)
+		if err != nil {
+			return err
+		}
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		exporter, err := export.NewExporter(client, registry, export.Config{
+			Dir:           c.String("out"),
+			From:          c.Uint64("from"),
+			To:            c.Uint64("to"),
+			PartitionSize: c.Uint64("partition"),
+			Formats:       formats,
+			Workers:       c.Int("workers"),
+		})
+		if err != nil {
+			return err
+		}
+		summary, err := exporter.Run(c.Context)
+		if err != nil {
+			return err
+		}
+		return printJSON(summary)
+	},
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+
+	"ethclient/devchain"
+	"ethclient/gasprof"
+	"github.com/urfave/cli/v2"
+)
+
+var gasProfileCommand = &cli.Command{
+	Name:  "gas-profile",
+	Usage: "在内存链上执行场景文件，统计每个合约方法的 gas 并与基线对比",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "scenario",
+			Usage:    "场景文件，格式见 gasprof.Scenario",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "输出格式: markdown 或 json",
+			Value: "markdown",
+		},
+		&cli.StringFlag{
+			Name:  "baseline",
+			Usage: "对比的基线文件，为空时不对比",
+		},
+		&cli.Float64Flag{
+			Name:  "threshold",
+			Usage: "平均 gas 相对基线上涨超过该百分比时失败",
+			Value: 5,
+		},
+		&cli.StringFlag{
+			Name:  "save-baseline",
+			Usage: "把本次结果保存为基线文件",
+		},
+		&cli.IntFlag{
+			Name:  "accounts",
+			Usage: "内存链上的账户数，场景中 from 的取值范围",
+			Value: 4,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		scenario, err := gasprof.LoadScenario(c.String("scenario"))
+		if err != nil {
+			return err
+		}
+		chain, err := devchain.New(c.Int("accounts"))
+		if err != nil {
+			return err
+		}
+		defer chain.Close()
+
+		report, err := gasprof.Run(c.Context, chain, scenario)
+		if err != nil {
+			return err
+		}
+		switch c.String("format") {
+		case "markdown":
+			fmt.Print(report.Markdown())
+		case "json":
+			if err := printJSON(report); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("未知的输出格式: %s", c.String("format"))
+		}
+		if path := c.String("save-baseline"); path != "" {
+			if err := report.Save(path); err != nil {
+				return err
+			}
+		}
+
+		if c.String("baseline") == "" {
+			return nil
+		}
+		baseline, err := gasprof.LoadReport(c.String("baseline"))
+		if err != nil {
+			return err
+		}
+		regressions := gasprof.Compare(report, baseline, c.Float64("threshold"))
+		if len(regressions) == 0 {
+			return nil
+		}
+		for _, r := range regressions {
+			fmt.Printf("gas 上涨 %s.%s: %d -> %d (+%.2f%%)\n", r.Contract, r.Method, r.Baseline, r.Current, r.Increase)
+		}
+		return fmt.Errorf("%d 个方法的 gas 上涨超过 %.2f%%", len(regressions), c.Float64("threshold"))
+	},
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+)
+
+// 轮询新区块的默认间隔，约等于一个出块时间
+const defaultPollInterval = 12 * time.Second
+
+// rpcFlag 所有需要连接节点的子命令共用
+var rpcFlag = &cli.StringFlag{
+	Name:    "rpc",
+	Usage:   "节点 RPC 地址",
+	EnvVars: []string{"ETH_RPC_URL"},
+	Value:   "http://127.0.0.1:8545",
+}
+
+// keyFlag 需要发送交易的子命令共用
+var keyFlag = &cli.StringFlag{
+	Name:    "key",
+	Usage:   "发送交易使用的私钥(十六进制)",
+	EnvVars: []string{"ETH_PRIVATE_KEY"},
+}
+
+// dial 根据 --rpc 连接节点
+func dial(c *cli.Context) (*ethclient.Client, error) {
+	return ethclient.DialContext(c.Context, c.String(rpcFlag.Name))
+}
+
+// addressFlag 读取并校验地址类型的参数
+func addressFlag(c *cli.Context, name string) (common.Address, error) {
+	value := c.String(name)
+	if !common.IsHexAddress(value) {
+		return common.Address{}, fmt.Errorf("无效的 --%s 地址: %s", name, value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+// transactor 根据 --key 和节点的 chainID 创建交易签名参数
+func transactor(c *cli.Context, client *ethclient.Client) (*bind.TransactOpts, error) {
+	hexKey := strings.TrimPrefix(c.String(keyFlag.Name), "0x")
+	if hexKey == "" {
+		return nil, fmt.Errorf("需要 --key")
+	}
+	privateKey, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("无效的私钥: %v", err)
+	}
+	chainID, err := client.ChainID(c.Context)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = c.Context
+	return opts, nil
+}
+
+// printJSON 以缩进格式把结果输出到标准输出
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func main() {
+	app := &cli.App{
+		Name:  "ethtool",
+		Usage: "链上运维工具",
+		Flags: []cli.Flag{rpcFlag, keyFlag},
+		Commands: []*cli.Command{
+			auditCommand,
+			nftIndexCommand,
+			votingCommand,
+			donationCommand,
+			erc20CheckCommand,
+			algoCheckCommand,
+			gasProfileCommand,
+			abiCommand,
+			decodeCommand,
+			mempoolCommand,
+			addrWatchCommand,
+			verifyCommand,
+			storageCommand,
+			exportCommand,
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"ethclient/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+var mempoolCommand = &cli.Command{
+	Name:  "mempool",
+	Usage: "监听发往关注合约的待处理交易，解码后每行输出一个 JSON",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "contract",
+			Usage: "关注的合约地址，可以指定多次",
+		},
+		&cli.StringFlag{
+			Name:  "factory",
+			Usage: "AuctionFactory 地址，关注它创建的全部拍卖",
+		},
+		&cli.StringSliceFlag{
+			Name:  "method",
+			Usage: "只输出调用这些方法的交易(如 placeBidETH)，可以指定多次",
+		},
+		abiDirFlag,
+		&cli.DurationFlag{
+			Name:  "poll",
+			Usage: "节点不支持订阅时轮询 txpool_content 的间隔",
+			Value: 2 * time.Second,
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "重新读取工厂拍卖列表的间隔",
+			Value: defaultPollInterval,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if len(c.StringSlice("contract")) == 0 && c.String("factory") == "" {
+			return fmt.Errorf("需要 --contract 或 --factory")
+		}
+		registry, err := decodeRegistry(c)
+		if err != nil {
+			return err
+		}
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		source := mempool.NewSource(c.Context, client.Client(), c.Duration("poll"))
+		watcher := mempool.NewWatcher(source, registry)
+		for _, value := range c.StringSlice("contract") {
+			if !common.IsHexAddress(value) {
+				return fmt.Errorf("无效的 --contract 地址: %s", value)
+			}
+			watcher.Track(common.HexToAddress(value), "")
+		}
+		watcher.FilterMethods(c.StringSlice("method")...)
+
+		if c.String("factory") != "" {
+			factory, err := addressFlag(c, "factory")
+			if err != nil {
+				return err
+			}
+			if _, err := watcher.TrackFactory(c.Context, factory, client); err != nil {
+				return fmt.Errorf("读取拍卖列表失败: %w", err)
+			}
+			// 定期加入工厂新创建的拍卖
+			go func() {
+				ticker := time.NewTicker(c.Duration("interval"))
+				defer ticker.Stop()
+				for {
+					select {
+					case <-c.Context.Done():
+						return
+					case <-ticker.C:
+						added, err := watcher.TrackFactory(c.Context, factory, client)
+						if err != nil {
+							log.Printf("读取拍卖列表失败: %v", err)
+						} else if added > 0 {
+							log.Printf("新关注 %d 个拍卖", added)
+						}
+					}
+				}
+			}()
+		}
+
+		pending, cancel := watcher.Subscribe(64)
+		defer cancel()
+		go func() {
+			encoder := json.NewEncoder(os.Stdout)
+			for p := range pending {
+				if err := encoder.Encode(p); err != nil {
+					log.Printf("输出待处理交易失败: %v", err)
+				}
+			}
+		}()
+		return watcher.Run(c.Context)
+	},
+}
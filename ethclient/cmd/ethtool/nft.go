@@ -0,0 +1,71 @@
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"ethclient/nftindex"
+	"github.com/urfave/cli/v2"
+)
+
+var nftIndexCommand = &cli.Command{
+	Name:  "nft-index",
+	Usage: "索引 NFT 持有关系并提供按地址查询 token 的 HTTP 接口",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "contract",
+			Usage:    "NftToken 合约地址",
+			Required: true,
+		},
+		&cli.Uint64Flag{
+			Name:  "from-block",
+			Usage: "开始索引的区块(合约部署区块)",
+		},
+		&cli.StringFlag{
+			Name:  "listen",
+			Usage: "HTTP 监听地址",
+			Value: ":8080",
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "轮询新区块的间隔",
+			Value: defaultPollInterval,
+		},
+		&cli.StringFlag{
+			Name:  "ipfs-gateway",
+			Usage: "解析 ipfs:// 使用的网关",
+			Value: "https://ipfs.io/ipfs/",
+		},
+		&cli.StringFlag{
+			Name:  "metadata-dir",
+			Usage: "解析本地路径 tokenURI 的根目录，只能读取该目录内的文件",
+			Value: ".",
+		},
+		&cli.StringSliceFlag{
+			Name:  "allow-host",
+			Usage: "http(s) tokenURI 允许访问的主机名，可以重复指定，不指定时不限制",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		contract, err := addressFlag(c, "contract")
+		if err != nil {
+			return err
+		}
+
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		resolver := nftindex.NewDefaultResolver(c.String("metadata-dir"), c.String("ipfs-gateway"), c.StringSlice("allow-host"))
+		indexer, err := nftindex.NewIndexer(client, contract, c.Uint64("from-block"), nftindex.NewMetadataCache(resolver))
+		if err != nil {
+			return err
+		}
+		go indexer.Run(c.Context, c.Duration("interval"))
+
+		log.Printf("nft-index listening on %s", c.String("listen"))
+		return http.ListenAndServe(c.String("listen"), indexer.Handler())
+	},
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+
+	"ethclient/slots"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+var storageCommand = &cli.Command{
+	Name:      "storage",
+	Usage:     "按 solc 存储布局直接读取合约存储，可以指定历史区块",
+	ArgsUsage: "[path...]  如 version、items[0x01]、crossChainBids[0x...].bidder，不指定时输出全部状态变量",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "contract",
+			Usage:    "合约地址",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "layout",
+			Usage: "solc --storage-layout 输出的 JSON，如 slots/Store.layout.json",
+		},
+		&cli.Uint64Flag{
+			Name:  "block",
+			Usage: "读取的区块，默认最新区块",
+		},
+		&cli.StringSliceFlag{
+			Name:  "slot",
+			Usage: "直接读取槽位的原始值(十进制或 0x 十六进制)，可以指定多次，不需要 --layout",
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "动态数组最多读取的元素个数",
+			Value: slots.DefaultLimit,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		contract, err := addressFlag(c, "contract")
+		if err != nil {
+			return err
+		}
+		var block *big.Int
+		if c.IsSet("block") {
+			block = new(big.Int).SetUint64(c.Uint64("block"))
+		}
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if len(c.StringSlice("slot")) > 0 {
+			inspector := slots.NewInspector(client, contract, nil)
+			words := make(map[string]string)
+			for _, value := range c.StringSlice("slot") {
+				slot, ok := new(big.Int).SetString(value, 0)
+				if !ok || slot.Sign() < 0 {
+					return fmt.Errorf("无效的槽位: %s", value)
+				}
+				word, err := inspector.Slot(c.Context, common.BigToHash(slot), block)
+				if err != nil {
+					return err
+				}
+				words[value] = common.BytesToHash(word).Hex()
+			}
+			return printJSON(words)
+		}
+
+		if c.String("layout") == "" {
+			return fmt.Errorf("需要 --layout 或 --slot")
+		}
+		layout, err := slots.LoadLayout(c.String("layout"))
+		if err != nil {
+			return err
+		}
+		inspector := slots.NewInspector(client, contract, layout)
+		inspector.Limit = c.Int("limit")
+		if c.NArg() == 0 {
+			results, err := inspector.Dump(c.Context, block)
+			if err != nil {
+				return err
+			}
+			return printJSON(results)
+		}
+		results := make([]*slots.Result, 0, c.NArg())
+		for _, path := range c.Args().Slice() {
+			result, err := inspector.Read(c.Context, path, block)
+			if err != nil {
+				return err
+			}
+			results = append(results, result)
+		}
+		return printJSON(results)
+	},
+}
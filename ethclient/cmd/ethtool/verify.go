@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+
+	"ethclient/txdecode"
+	"ethclient/verify"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+var verifyCommand = &cli.Command{
+	Name:  "verify",
+	Usage: "离线校验合约地址上的代码是否由本地编译产物部署",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "address",
+			Usage:    "合约地址",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "bin",
+			Usage: "solc --bin 输出的创建字节码文件，如 genCode/count_sol_Count.bin",
+		},
+		&cli.StringFlag{
+			Name:  "abi",
+			Usage: "和 --bin 对应的 ABI 文件，用于解码构造参数",
+		},
+		&cli.StringFlag{
+			Name:  "binding",
+			Usage: "使用 genCode 中绑定的字节码，如 Count，代替 --bin",
+		},
+		&cli.StringFlag{
+			Name:  "tx",
+			Usage: "部署交易哈希，提供时同时校验创建字节码并解码构造参数",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		address, err := addressFlag(c, "address")
+		if err != nil {
+			return err
+		}
+		var artifact *verify.Artifact
+		switch {
+		case c.String("binding") != "":
+			meta, ok := txdecode.Binding(c.String("binding"))
+			if !ok {
+				return fmt.Errorf("genCode 中没有绑定 %s", c.String("binding"))
+			}
+			artifact, err = verify.FromMetaData(c.String("binding"), meta)
+		case c.String("bin") != "":
+			artifact, err = verify.ReadArtifact(c.String("bin"), c.String("bin"), c.String("abi"))
+		default:
+			return fmt.Errorf("需要 --bin 或 --binding")
+		}
+		if err != nil {
+			return err
+		}
+
+		var creationTx *common.Hash
+		if c.String("tx") != "" {
+			hash := common.HexToHash(c.String("tx"))
+			creationTx = &hash
+		}
+		client, err := dial(c)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		report, err := verify.Verify(c.Context, client, address, artifact, creationTx)
+		if err != nil {
+			return err
+		}
+		if err := printJSON(report); err != nil {
+			return err
+		}
+		if report.Status != verify.StatusMatch && report.Status != verify.StatusPartial {
+			return fmt.Errorf("%s 上的代码和 %s 不一致: %s", address.Hex(), artifact.Name, report.Status)
+		}
+		return nil
+	},
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"ethclient/voting"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+)
+
+var votingContractFlag = &cli.StringFlag{
+	Name:     "contract",
+	Usage:    "Voting 合约地址",
+	Required: true,
+}
+
+var votingCommand = &cli.Command{
+	Name:  "voting",
+	Usage: "Voting 合约投票、计票与轮次统计",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "vote",
+			Usage:     "给候选人投票",
+			ArgsUsage: "<candidate>",
+			Flags:     []cli.Flag{votingContractFlag},
+			Action: func(c *cli.Context) error {
+				if c.NArg() != 1 {
+					return fmt.Errorf("需要一个候选人参数")
+				}
+				client, votes, err := votingClient(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				opts, err := transactor(c, client)
+				if err != nil {
+					return err
+				}
+				tx, err := votes.Vote(opts, c.Args().First())
+				if err != nil {
+					return err
+				}
+				fmt.Printf("tx sent: %s\n", tx.Hash().Hex())
+				return nil
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "清空票数，开始新一轮",
+			Flags: []cli.Flag{votingContractFlag},
+			Action: func(c *cli.Context) error {
+				client, votes, err := votingClient(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				opts, err := transactor(c, client)
+				if err != nil {
+					return err
+				}
+				tx, err := votes.Reset(opts)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("tx sent: %s\n", tx.Hash().Hex())
+				return nil
+			},
+		},
+		{
+			Name:  "tally",
+			Usage: "读取候选人当前票数",
+			Flags: []cli.Flag{
+				votingContractFlag,
+				&cli.StringFlag{
+					Name:     "candidates",
+					Usage:    "逗号分隔的候选人列表",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				client, votes, err := votingClient(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				tallies, err := votes.Tally(c.Context, strings.Split(c.String("candidates"), ","), nil)
+				if err != nil {
+					return err
+				}
+				return printJSON(tallies)
+			},
+		},
+		{
+			Name:  "rounds",
+			Usage: "从区块数据统计每一轮的结果和重复投票的账户",
+			Flags: []cli.Flag{
+				votingContractFlag,
+				&cli.Uint64Flag{
+					Name:     "from-block",
+					Usage:    "开始扫描的区块",
+					Required: true,
+				},
+				&cli.Uint64Flag{
+					Name:  "to-block",
+					Usage: "结束扫描的区块，默认最新区块",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				contract, err := addressFlag(c, votingContractFlag.Name)
+				if err != nil {
+					return err
+				}
+				client, err := dial(c)
+				if err != nil {
+					return err
+				}
+				defer client.Close()
+
+				to := c.Uint64("to-block")
+				if to == 0 {
+					if to, err = client.BlockNumber(c.Context); err != nil {
+						return err
+					}
+				}
+				tracker, err := voting.NewTracker(client, contract)
+				if err != nil {
+					return err
+				}
+				rounds, err := tracker.Rounds(c.Context, c.Uint64("from-block"), to)
+				if err != nil {
+					return err
+				}
+				return printJSON(rounds)
+			},
+		},
+	},
+}
+
+// votingClient 连接节点并绑定 --contract 指定的 Voting 合约
+func votingClient(c *cli.Context) (*ethclient.Client, *voting.Client, error) {
+	contract, err := addressFlag(c, votingContractFlag.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := dial(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	votes, err := voting.NewClient(contract, client)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return client, votes, nil
+}
@@ -0,0 +1,105 @@
+package devchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+/**
+内存中的开发链
+    基于 ethclient/simulated，预置若干有 ETH 的账户
+    部署合约并等待上链，用于一致性检查、差分测试和 gas 统计
+*/
+
+// 每个账户的初始 ETH
+var initialFunds = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+
+// Chain 内存链和预置账户
+type Chain struct {
+	backend *simulated.Backend
+	// Client 满足 bind.ContractBackend 和 bind.DeployBackend
+	Client   simulated.Client
+	Accounts []*bind.TransactOpts
+}
+
+// New 创建内存链和 n 个有 ETH 的账户，用完后调用 Close
+func New(n int) (*Chain, error) {
+	alloc := make(types.GenesisAlloc)
+	accounts := make([]*bind.TransactOpts, n)
+	for i := range accounts {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		opts, err := bind.NewKeyedTransactorWithChainID(key, params.AllDevChainProtocolChanges.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		accounts[i] = opts
+		alloc[opts.From] = types.Account{Balance: initialFunds}
+	}
+	backend := simulated.NewBackend(alloc)
+	return &Chain{
+		backend:  backend,
+		Client:   backend.Client(),
+		Accounts: accounts,
+	}, nil
+}
+
+// Commit 把交易池中的交易打包出块
+func (c *Chain) Commit() {
+	c.backend.Commit()
+}
+
+// AdjustTime 出一个时间比上一个区块晚 d 的空块，用于测试依赖区块时间的合约
+func (c *Chain) AdjustTime(d time.Duration) error {
+	return c.backend.AdjustTime(d)
+}
+
+func (c *Chain) Close() error {
+	return c.backend.Close()
+}
+
+// Deploy 由 from 部署合约并出块，code 是 solc --bin 输出的创建字节码，args 是构造函数参数
+func (c *Chain) Deploy(ctx context.Context, from *bind.TransactOpts, parsed *abi.ABI, code []byte, args ...interface{}) (common.Address, *types.Receipt, error) {
+	opts := *from
+	opts.Context = ctx
+	address, tx, _, err := bind.DeployContract(&opts, *parsed, code, c.Client, args...)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("部署合约失败: %w", err)
+	}
+	c.Commit()
+	receipt, err := bind.WaitMined(ctx, c.Client, tx)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return common.Address{}, receipt, fmt.Errorf("部署交易 %s 执行失败", tx.Hash().Hex())
+	}
+	return address, receipt, nil
+}
+
+// ReadBin 读取 solc --bin 输出的十六进制字节码文件，允许 0x 前缀和换行
+func ReadBin(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	code := common.FromHex(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%s 中没有字节码", path)
+	}
+	return code, nil
+}
@@ -0,0 +1,195 @@
+package donation
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"ethclient/devchain"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// deploy 在内存链上部署 BeggingContract，owner 为 Accounts[0]，不访问任何真实的链
+func deploy(t *testing.T, accounts int) (*devchain.Chain, common.Address, *genCode.BeggingContract) {
+	t.Helper()
+	parsed, err := genCode.BeggingContractMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chain, err := devchain.New(accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	address, _, err := chain.Deploy(context.Background(), chain.Accounts[0], parsed, common.FromHex(genCode.BeggingContractMetaData.Bin))
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, err := genCode.NewBeggingContract(address, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chain, address, contract
+}
+
+// donate 由 from 捐赠 amount wei 并出块
+func donate(t *testing.T, chain *devchain.Chain, contract *genCode.BeggingContract, from *bind.TransactOpts, amount int64) {
+	t.Helper()
+	opts := *from
+	opts.Value = big.NewInt(amount)
+	if _, err := contract.Donate(&opts); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+}
+
+func head(t *testing.T, chain *devchain.Chain) uint64 {
+	t.Helper()
+	number, err := chain.Client.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return number
+}
+
+func TestTrackerSync(t *testing.T) {
+	chain, address, contract := deploy(t, 3)
+	alice, bob := chain.Accounts[1], chain.Accounts[2]
+	donate(t, chain, contract, alice, 100)
+	donate(t, chain, contract, bob, 300)
+	donate(t, chain, contract, alice, 150)
+
+	for _, fromTransactions := range []bool{false, true} {
+		tracker, err := NewTracker(address, chain.Client, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromTransactions {
+			tracker.FromTransactions(chain.Client)
+		}
+		added, err := tracker.Sync(context.Background(), head(t, chain))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(added) != 3 {
+			t.Fatalf("fromTransactions=%v: 记录了 %d 笔捐赠, want 3", fromTransactions, len(added))
+		}
+		if added[0].Donor != alice.From || added[0].Amount.Int64() != 100 || added[1].Donor != bob.From {
+			t.Fatalf("fromTransactions=%v: 捐赠顺序或内容不正确: %+v", fromTransactions, added)
+		}
+
+		board := tracker.Leaderboard(0)
+		if len(board) != 2 {
+			t.Fatalf("fromTransactions=%v: 排行榜有 %d 人, want 2", fromTransactions, len(board))
+		}
+		if board[0].Donor != bob.From || board[0].Total.Int64() != 300 || board[0].Count != 1 {
+			t.Fatalf("fromTransactions=%v: 第一名 %+v", fromTransactions, board[0])
+		}
+		if board[1].Donor != alice.From || board[1].Total.Int64() != 250 || board[1].Count != 2 {
+			t.Fatalf("fromTransactions=%v: 第二名 %+v", fromTransactions, board[1])
+		}
+
+		// 没有新区块时再次同步不会重复记录
+		added, err = tracker.Sync(context.Background(), head(t, chain))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(added) != 0 || len(tracker.Donations()) != 3 {
+			t.Fatalf("fromTransactions=%v: 重复同步记录了 %d 笔捐赠", fromTransactions, len(added))
+		}
+	}
+
+	// 链下统计和合约记录一致
+	total, err := contract.GetDonation(&bind.CallOpts{}, alice.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Int64() != 250 {
+		t.Fatalf("合约记录 alice 捐赠 %s, want 250", total)
+	}
+}
+
+func TestAutoWithdrawer(t *testing.T) {
+	chain, address, contract := deploy(t, 2)
+	owner, donor := chain.Accounts[0], chain.Accounts[1]
+	ctx := context.Background()
+
+	withdrawer, err := NewAutoWithdrawer(address, chain.Client, owner, big.NewInt(500))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	donate(t, chain, contract, donor, 200)
+	receipt, err := withdrawer.Check(ctx)
+	if err != nil || receipt != nil {
+		t.Fatalf("余额未达到阈值时不应提取: receipt=%v err=%v", receipt, err)
+	}
+
+	donate(t, chain, contract, donor, 400)
+	before, err := chain.Client.BalanceAt(ctx, owner.From, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 内存链不会自动出块，等待 withdraw 上链期间持续出块
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				chain.Commit()
+			}
+		}
+	}()
+	receipt, err = withdrawer.Check(ctx)
+	close(done)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("余额达到阈值时应提取成功: %+v", receipt)
+	}
+
+	balance, err := chain.Client.BalanceAt(ctx, address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Sign() != 0 {
+		t.Fatalf("提取后合约余额 %s, want 0", balance)
+	}
+	after, err := chain.Client.BalanceAt(ctx, owner.From, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// owner 收到全部 600 wei，并支付 withdraw 的 gas
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	want := new(big.Int).Add(before, big.NewInt(600))
+	if want.Sub(want, fee).Cmp(after) != 0 {
+		t.Fatalf("提取后 owner 余额 %s, want %s", after, want)
+	}
+
+	iter, err := contract.FilterWithdrawal(&bind.FilterOpts{Context: ctx}, []common.Address{owner.From})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+	var withdrawn []*big.Int
+	for iter.Next() {
+		withdrawn = append(withdrawn, iter.Event.Amount)
+	}
+	if err := iter.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if len(withdrawn) != 1 || withdrawn[0].Int64() != 600 {
+		t.Fatalf("Withdrawal 事件 %v, want [600]", withdrawn)
+	}
+}
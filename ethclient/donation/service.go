@@ -0,0 +1,92 @@
+package donation
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ethclient/units"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Service 定时同步捐赠记录并检查是否需要自动提取
+type Service struct {
+	backend bind.ContractBackend
+	tracker *Tracker
+	// 为 nil 时只记录捐赠不提取
+	withdrawer *AutoWithdrawer
+}
+
+func NewService(backend bind.ContractBackend, tracker *Tracker, withdrawer *AutoWithdrawer) *Service {
+	return &Service{
+		backend:    backend,
+		tracker:    tracker,
+		withdrawer: withdrawer,
+	}
+}
+
+// Run 按 interval 轮询，直到 ctx 结束
+func (s *Service) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) poll(ctx context.Context) {
+	header, err := s.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		log.Printf("获取最新区块失败: %v", err)
+		return
+	}
+	added, err := s.tracker.Sync(ctx, header.Number.Uint64())
+	if err != nil {
+		log.Printf("同步捐赠失败: %v", err)
+	}
+	for _, d := range added {
+		log.Printf("捐赠: %s %s (block %d, tx %s)", d.Donor.Hex(), units.FormatEther(d.Amount), d.Block, d.TxHash.Hex())
+	}
+
+	if s.withdrawer == nil {
+		return
+	}
+	receipt, err := s.withdrawer.Check(ctx)
+	if err != nil {
+		log.Printf("自动提取失败: %v", err)
+		return
+	}
+	if receipt != nil {
+		log.Printf("已自动提取: tx %s", receipt.TxHash.Hex())
+	}
+}
+
+// Handler 返回 HTTP 路由
+//
+//	GET /leaderboard?top=3
+//	GET /donations
+func (s *Service) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /leaderboard", func(w http.ResponseWriter, r *http.Request) {
+		top, _ := strconv.Atoi(r.URL.Query().Get("top"))
+		writeJSON(w, s.tracker.Leaderboard(top))
+	})
+	mux.HandleFunc("GET /donations", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, s.tracker.Donations())
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,230 @@
+package donation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+solidity_task/task2/BeggingContract.sol 的捐赠记录服务
+    从 Donation 事件（旧部署没有事件时从 donate 交易）记录每笔捐赠
+    维护捐赠排行榜
+    合约余额超过阈值时由 owner 自动调用 withdraw
+*/
+
+// 每次 FilterDonation 查询的区块范围
+const defaultBatch = 2000
+
+// Donation 一笔捐赠
+type Donation struct {
+	Donor  common.Address `json:"donor"`
+	Amount *big.Int       `json:"amount"`
+	Block  uint64         `json:"block"`
+	TxHash common.Hash    `json:"txHash"`
+}
+
+// Entry 排行榜中的一个捐赠者
+type Entry struct {
+	Donor common.Address `json:"donor"`
+	Total *big.Int       `json:"total"`
+	Count int            `json:"count"`
+}
+
+type Tracker struct {
+	address  common.Address
+	contract *genCode.BeggingContract
+	batch    uint64
+	// 不为 nil 时从 donate 交易而不是 Donation 事件还原捐赠
+	reader ChainReader
+
+	lock      sync.RWMutex
+	donations []Donation
+	totals    map[common.Address]*Entry
+	// 下一个待处理的区块高度
+	next uint64
+}
+
+func NewTracker(address common.Address, backend bind.ContractBackend, startBlock uint64) (*Tracker, error) {
+	contract, err := genCode.NewBeggingContract(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		address:  address,
+		contract: contract,
+		batch:    defaultBatch,
+		totals:   make(map[common.Address]*Entry),
+		next:     startBlock,
+	}, nil
+}
+
+// FromTransactions 改为扫描 donate 交易记录捐赠，用于没有 Donation 事件的旧部署
+func (t *Tracker) FromTransactions(reader ChainReader) {
+	t.reader = reader
+}
+
+// Sync 读取到 head 为止的捐赠，返回新增的捐赠
+func (t *Tracker) Sync(ctx context.Context, head uint64) ([]Donation, error) {
+	if t.reader != nil {
+		from := t.Next()
+		if from > head {
+			return nil, nil
+		}
+		added, err := ScanTransactions(ctx, t.reader, t.address, from, head)
+		if err != nil {
+			return nil, err
+		}
+		t.Record(head+1, added...)
+		return added, nil
+	}
+
+	var added []Donation
+	for from := t.Next(); from <= head; {
+		to := from + t.batch - 1
+		if to > head {
+			to = head
+		}
+		end := to
+		iter, err := t.contract.FilterDonation(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, nil)
+		if err != nil {
+			return added, fmt.Errorf("查询区块 %d-%d 的 Donation 事件失败: %w", from, to, err)
+		}
+		var batch []Donation
+		for iter.Next() {
+			batch = append(batch, Donation{
+				Donor:  iter.Event.Donor,
+				Amount: iter.Event.Amount,
+				Block:  iter.Event.Raw.BlockNumber,
+				TxHash: iter.Event.Raw.TxHash,
+			})
+		}
+		err = iter.Error()
+		iter.Close()
+		if err != nil {
+			return added, err
+		}
+		t.Record(to+1, batch...)
+		added = append(added, batch...)
+		from = to + 1
+	}
+	return added, nil
+}
+
+// Record 记录捐赠并把下一个待处理区块推进到 next
+func (t *Tracker) Record(next uint64, donations ...Donation) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, d := range donations {
+		t.donations = append(t.donations, d)
+		entry, ok := t.totals[d.Donor]
+		if !ok {
+			entry = &Entry{Donor: d.Donor, Total: new(big.Int)}
+			t.totals[d.Donor] = entry
+		}
+		entry.Total.Add(entry.Total, d.Amount)
+		entry.Count++
+	}
+	if next > t.next {
+		t.next = next
+	}
+}
+
+// Next 下一个待处理的区块高度
+func (t *Tracker) Next() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.next
+}
+
+// Donations 已记录的全部捐赠
+func (t *Tracker) Donations() []Donation {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return append([]Donation(nil), t.donations...)
+}
+
+// Leaderboard 按累计金额从高到低返回前 n 名，n <= 0 时返回全部
+func (t *Tracker) Leaderboard(n int) []Entry {
+	t.lock.RLock()
+	entries := make([]Entry, 0, len(t.totals))
+	for _, entry := range t.totals {
+		entries = append(entries, Entry{Donor: entry.Donor, Total: new(big.Int).Set(entry.Total), Count: entry.Count})
+	}
+	t.lock.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if c := entries[i].Total.Cmp(entries[j].Total); c != 0 {
+			return c > 0
+		}
+		return entries[i].Donor.Cmp(entries[j].Donor) < 0
+	})
+	if n > 0 && len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// ChainReader 扫描交易需要的区块读取能力，*ethclient.Client 满足
+type ChainReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// ScanTransactions 从 [from, to] 区块中成功的 donate 交易还原捐赠，用于没有 Donation 事件的旧部署
+func ScanTransactions(ctx context.Context, reader ChainReader, contract common.Address, from, to uint64) ([]Donation, error) {
+	parsed, err := genCode.BeggingContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	selector := parsed.Methods["donate"].ID
+	chainID, err := reader.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+
+	var donations []Donation
+	for number := from; number <= to; number++ {
+		block, err := reader.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("读取区块 %d 失败: %w", number, err)
+		}
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != contract || len(tx.Data()) < 4 || tx.Value().Sign() == 0 {
+				continue
+			}
+			if !bytes.Equal(tx.Data()[:4], selector) {
+				continue
+			}
+			receipt, err := reader.TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				return nil, fmt.Errorf("读取交易 %s 收据失败: %w", tx.Hash().Hex(), err)
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				continue
+			}
+			sender, err := types.Sender(signer, tx)
+			if err != nil {
+				return nil, err
+			}
+			donations = append(donations, Donation{
+				Donor:  sender,
+				Amount: tx.Value(),
+				Block:  number,
+				TxHash: tx.Hash(),
+			})
+		}
+	}
+	return donations, nil
+}
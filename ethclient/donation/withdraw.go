@@ -0,0 +1,74 @@
+package donation
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend 自动提取需要的链上访问能力
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ethereum.ChainStateReader
+}
+
+// AutoWithdrawer 合约余额达到阈值时用 owner 账户调用 withdraw
+type AutoWithdrawer struct {
+	backend   Backend
+	address   common.Address
+	contract  *genCode.BeggingContract
+	opts      *bind.TransactOpts
+	threshold *big.Int
+}
+
+// NewAutoWithdrawer opts 必须是合约 owner 的签名参数
+func NewAutoWithdrawer(address common.Address, backend Backend, opts *bind.TransactOpts, threshold *big.Int) (*AutoWithdrawer, error) {
+	if threshold == nil || threshold.Sign() <= 0 {
+		return nil, fmt.Errorf("提取阈值必须大于 0")
+	}
+	contract, err := genCode.NewBeggingContract(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AutoWithdrawer{
+		backend:   backend,
+		address:   address,
+		contract:  contract,
+		opts:      opts,
+		threshold: threshold,
+	}, nil
+}
+
+// Check 余额未达到阈值时返回 nil；达到阈值时发送 withdraw 并等待上链
+func (w *AutoWithdrawer) Check(ctx context.Context) (*types.Receipt, error) {
+	balance, err := w.backend.BalanceAt(ctx, w.address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("查询合约余额失败: %w", err)
+	}
+	if balance.Cmp(w.threshold) < 0 {
+		return nil, nil
+	}
+
+	opts := *w.opts
+	opts.Context = ctx
+	tx, err := w.contract.Withdraw(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("发送 withdraw 失败: %w", err)
+	}
+	// 等待上链，避免下一次检查时重复提取
+	receipt, err := bind.WaitMined(ctx, w.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("withdraw 交易 %s 执行失败", tx.Hash().Hex())
+	}
+	return receipt, nil
+}
@@ -0,0 +1,227 @@
+package dyncall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"ethclient/abiargs"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+不需要 abigen 绑定的合约客户端
+    从 .abi 文件加载合约接口(如 Store_sol_Store.abi、count_sol_Count.abi)
+    按方法名调用 view 方法、发送交易，参数用 JSON 表示(格式见 abiargs)
+    按 ABI 解码事件
+*/
+
+// Contract 一个合约地址和它的 ABI
+type Contract struct {
+	Address common.Address
+	ABI     *abi.ABI
+	backend bind.ContractBackend
+	bound   *bind.BoundContract
+}
+
+// LoadABI 读取 solc --abi 输出的文件
+func LoadABI(path string) (*abi.ABI, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	parsed, err := abi.JSON(file)
+	if err != nil {
+		return nil, fmt.Errorf("解析 ABI %s 失败: %w", path, err)
+	}
+	return &parsed, nil
+}
+
+func NewContract(address common.Address, parsed *abi.ABI, backend bind.ContractBackend) *Contract {
+	return &Contract{
+		Address: address,
+		ABI:     parsed,
+		backend: backend,
+		bound:   bind.NewBoundContract(address, *parsed, backend, backend, backend),
+	}
+}
+
+// Method 按方法名或完整签名(如 "transfer(address,uint256)")查找方法，重载的方法必须用签名
+func (c *Contract) Method(name string) (abi.Method, error) {
+	if strings.Contains(name, "(") {
+		for _, method := range c.ABI.Methods {
+			if method.Sig == name {
+				return method, nil
+			}
+		}
+		return abi.Method{}, fmt.Errorf("ABI 中没有方法 %s", name)
+	}
+	var matched []abi.Method
+	for _, method := range c.ABI.Methods {
+		if method.RawName == name {
+			matched = append(matched, method)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return abi.Method{}, fmt.Errorf("ABI 中没有方法 %s", name)
+	case 1:
+		return matched[0], nil
+	}
+	sigs := make([]string, len(matched))
+	for i, method := range matched {
+		sigs[i] = method.Sig
+	}
+	return abi.Method{}, fmt.Errorf("方法 %s 有重载，请使用完整签名: %s", name, strings.Join(sigs, ", "))
+}
+
+// Call 调用方法并返回按输出参数名组织的结果，block 为 nil 时读取最新区块
+// 非 view 方法也可以调用，相当于模拟执行交易
+func (c *Contract) Call(ctx context.Context, from common.Address, name string, args []json.RawMessage, block *big.Int) (map[string]interface{}, error) {
+	method, err := c.Method(name)
+	if err != nil {
+		return nil, err
+	}
+	values, err := abiargs.Pack(method.Inputs, args)
+	if err != nil {
+		return nil, err
+	}
+	var out []interface{}
+	opts := &bind.CallOpts{Context: ctx, From: from, BlockNumber: block}
+	if err := c.bound.Call(opts, &out, method.Name, values...); err != nil {
+		return nil, err
+	}
+	return abiargs.FormatAll(method.Outputs, out), nil
+}
+
+// Transact 发送交易，随交易发送的 ETH 由 opts.Value 指定
+func (c *Contract) Transact(opts *bind.TransactOpts, name string, args []json.RawMessage) (*types.Transaction, error) {
+	method, err := c.Method(name)
+	if err != nil {
+		return nil, err
+	}
+	if method.IsConstant() {
+		return nil, fmt.Errorf("%s 是 %s 方法，请使用 call", method.Sig, method.StateMutability)
+	}
+	if opts.Value != nil && opts.Value.Sign() > 0 && !method.IsPayable() {
+		return nil, fmt.Errorf("%s 不是 payable 方法，不能附带 ETH", method.Sig)
+	}
+	values, err := abiargs.Pack(method.Inputs, args)
+	if err != nil {
+		return nil, err
+	}
+	return c.bound.Transact(opts, method.Name, values...)
+}
+
+// Event 解码后的事件
+type Event struct {
+	Name        string                 `json:"name"`
+	Signature   string                 `json:"signature"`
+	Args        map[string]interface{} `json:"args"`
+	BlockNumber uint64                 `json:"blockNumber"`
+	TxHash      common.Hash            `json:"txHash"`
+	LogIndex    uint                   `json:"logIndex"`
+}
+
+// DecodeLog 按 ABI 解码一条日志，ABI 中没有对应事件时返回 nil
+// indexed 的 string/bytes/数组参数在日志中只有 keccak256 哈希，输出为哈希
+func (c *Contract) DecodeLog(l types.Log) (*Event, error) {
+	if len(l.Topics) == 0 {
+		return nil, nil
+	}
+	event, err := c.ABI.EventByID(l.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+	data, err := event.Inputs.NonIndexed().UnpackValues(l.Data)
+	if err != nil {
+		return nil, fmt.Errorf("解码 %s 数据失败: %w", event.Name, err)
+	}
+	if len(l.Topics)-1 != len(event.Inputs)-len(data) {
+		return nil, fmt.Errorf("%s 的 topic 数量不匹配", event.Name)
+	}
+	args := make(map[string]interface{}, len(event.Inputs))
+	topics, values := l.Topics[1:], data
+	for i, arg := range event.Inputs {
+		name := arg.Name
+		if name == "" {
+			name = "#" + strconv.Itoa(i)
+		}
+		if !arg.Indexed {
+			args[name] = abiargs.Format(arg.Type, values[0])
+			values = values[1:]
+			continue
+		}
+		topic := topics[0]
+		topics = topics[1:]
+		if isHashedTopic(arg.Type) {
+			args[name] = topic.Hex()
+			continue
+		}
+		// 静态类型的 topic 编码和普通参数一致，按非 indexed 参数解码
+		plain := arg
+		plain.Indexed = false
+		value, err := abi.Arguments{plain}.UnpackValues(topic.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("解码 %s.%s 失败: %w", event.Name, name, err)
+		}
+		args[name] = abiargs.Format(arg.Type, value[0])
+	}
+	return &Event{
+		Name:        event.Name,
+		Signature:   event.Sig,
+		Args:        args,
+		BlockNumber: l.BlockNumber,
+		TxHash:      l.TxHash,
+		LogIndex:    l.Index,
+	}, nil
+}
+
+// Events 查询 [from, to] 区块中合约的事件，name 不为空时只返回该事件，to 为 nil 时到最新区块
+func (c *Contract) Events(ctx context.Context, name string, from uint64, to *big.Int) ([]*Event, error) {
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   to,
+		Addresses: []common.Address{c.Address},
+	}
+	if name != "" {
+		event, ok := c.ABI.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("ABI 中没有事件 %s", name)
+		}
+		query.Topics = [][]common.Hash{{event.ID}}
+	}
+	logs, err := c.backend.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*Event, 0, len(logs))
+	for _, l := range logs {
+		event, err := c.DecodeLog(l)
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// isHashedTopic 动态类型作为 indexed 参数时日志中只保存哈希
+func isHashedTopic(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package dyncall
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ethclient/devchain"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testABI = `[
+	{"type": "function", "name": "transfer", "stateMutability": "nonpayable",
+	 "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]},
+	{"type": "function", "name": "transfer", "stateMutability": "nonpayable",
+	 "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}, {"name": "data", "type": "bytes"}], "outputs": []},
+	{"type": "function", "name": "balanceOf", "stateMutability": "view",
+	 "inputs": [{"name": "account", "type": "address"}], "outputs": [{"name": "", "type": "uint256"}]},
+	{"type": "function", "name": "deposit", "stateMutability": "payable",
+	 "inputs": [{"name": "ids", "type": "uint64[2]"}, {"name": "tag", "type": "bytes4"}], "outputs": []}
+]`
+
+func testContract(t *testing.T) *Contract {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 参数检查在访问链之前完成，不需要 backend
+	return NewContract(common.Address{}, &parsed, nil)
+}
+
+func rawArgs(values ...string) []json.RawMessage {
+	result := make([]json.RawMessage, len(values))
+	for i, v := range values {
+		result[i] = json.RawMessage(v)
+	}
+	return result
+}
+
+func TestMethod(t *testing.T) {
+	c := testContract(t)
+	// want 为空时期望查找失败
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"balanceOf", "balanceOf(address)"},
+		{"balanceOf(address)", "balanceOf(address)"},
+		{"transfer(address,uint256)", "transfer(address,uint256)"},
+		{"transfer(address,uint256,bytes)", "transfer(address,uint256,bytes)"},
+		{"transfer", ""},
+		{"transfer(address)", ""},
+		{"transfer(address, uint256)", ""},
+		{"mint", ""},
+	}
+	for _, tt := range tests {
+		method, err := c.Method(tt.name)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("Method(%s) = %s, want error", tt.name, method.Sig)
+			}
+			continue
+		}
+		if err != nil || method.Sig != tt.want {
+			t.Errorf("Method(%s) = %s, %v, want %s", tt.name, method.Sig, err, tt.want)
+		}
+	}
+}
+
+// TestTransactRejects 方法和参数不合法时不发送交易
+func TestTransactRejects(t *testing.T) {
+	c := testContract(t)
+	const to = `"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`
+	tests := []struct {
+		name  string
+		args  []json.RawMessage
+		value int64
+		want  string
+	}{
+		{"balanceOf", rawArgs(to), 0, "请使用 call"},
+		{"transfer(address,uint256)", rawArgs(to, `"1"`), 1, "不是 payable 方法"},
+		{"transfer(address,uint256)", rawArgs(to), 0, "需要 2 个参数"},
+		{"transfer(address,uint256)", rawArgs(`"0x1234"`, `"1"`), 0, "参数 to(address)"},
+		{"transfer(address,uint256)", rawArgs(to, `"-1"`), 0, "参数 amount(uint256)"},
+		{"transfer(address,uint256)", rawArgs(to, `"0x1g"`), 0, "参数 amount(uint256)"},
+		{"transfer(address,uint256,bytes)", rawArgs(to, `"1"`, `"0x0"`), 0, "参数 data(bytes)"},
+		{"deposit", rawArgs(`[1]`, `"0x01"`), 1, "参数 ids(uint64[2])"},
+		{"deposit", rawArgs(`[1, "18446744073709551616"]`, `"0x01"`), 1, "参数 ids(uint64[2])"},
+		{"deposit", rawArgs(`[1, 2]`, `"0x0102030405"`), 1, "参数 tag(bytes4)"},
+	}
+	for _, tt := range tests {
+		opts := &bind.TransactOpts{Value: big.NewInt(tt.value)}
+		if _, err := c.Transact(opts, tt.name, tt.args); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Transact(%s, %s) error = %v, want %q", tt.name, tt.args, err, tt.want)
+		}
+	}
+}
+
+// TestContract 在内存链上用 JSON 参数调用 Erc201
+func TestContract(t *testing.T) {
+	chain, err := devchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	ctx := context.Background()
+	parsed, err := genCode.Erc201MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner, holder := chain.Accounts[0], chain.Accounts[1].From
+	address, _, err := chain.Deploy(ctx, owner, parsed, common.FromHex(genCode.Erc201MetaData.Bin), "Token", "TK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewContract(address, parsed, chain.Client)
+
+	// 十六进制和十进制字符串表示同一个数
+	holderArg := `"` + strings.ToLower(holder.Hex()) + `"`
+	for _, amount := range []string{`"0x3e8"`, `"1000"`} {
+		if _, err := c.Transact(owner, "mint", rawArgs(holderArg, amount)); err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+	}
+
+	tests := []struct {
+		name string
+		args []json.RawMessage
+		want map[string]interface{}
+	}{
+		{"balanceOf", rawArgs(holderArg), map[string]interface{}{"#0": "2000"}},
+		// 构造函数给部署者发行了 1000000 个代币
+		{"balanceOf", rawArgs(`"` + owner.From.Hex() + `"`), map[string]interface{}{"#0": "1000000000000000000000000"}},
+		{"name", nil, map[string]interface{}{"#0": "Token"}},
+		{"symbol", nil, map[string]interface{}{"#0": "TK"}},
+		{"decimals", nil, map[string]interface{}{"#0": "18"}},
+	}
+	for _, tt := range tests {
+		got, err := c.Call(ctx, owner.From, tt.name, tt.args, nil)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Call(%s, %s) = %v, %v, want %v", tt.name, tt.args, got, err, tt.want)
+		}
+	}
+
+	events, err := c.Events(ctx, "Transfer", 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Events = %d 个, want 3", len(events))
+	}
+	want := map[string]interface{}{"from": common.Address{}.Hex(), "to": holder.Hex(), "value": "1000"}
+	for _, event := range events[1:] {
+		if event.Name != "Transfer" || !reflect.DeepEqual(event.Args, want) {
+			t.Errorf("Event = %s %v, want Transfer %v", event.Name, event.Args, want)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package erc20check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+ERC-20 一致性检查
+对任意 ERC-20 合约依次执行标准场景，报告所有和 EIP-20 不一致的地方：
+    方法和事件签名
+    totalSupply 与余额
+    transfer、零值转账、余额不足、转给零地址
+    approve、allowance、transferFrom 扣减授权
+    零地址相关的约定
+场景会真实发送交易，应当在 simulated 后端或测试链上运行。
+*/
+
+// Severity 不一致的严重程度
+type Severity string
+
+const (
+	// EIP-20 中的 MUST
+	SeverityMust Severity = "MUST"
+	// EIP-20 中的 SHOULD
+	SeverityShould Severity = "SHOULD"
+	// 不是 EIP-20 的要求，但主流实现(OpenZeppelin)都遵守的约定
+	SeverityConvention Severity = "CONVENTION"
+)
+
+// Result 一条检查的结果
+type Result struct {
+	Scenario string   `json:"scenario"`
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Passed   bool     `json:"passed"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// Report 一次检查的全部结果
+type Report struct {
+	Token   common.Address `json:"token"`
+	Results []Result       `json:"results"`
+}
+
+// Deviations 没有通过的检查
+func (r *Report) Deviations() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if !result.Passed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Backend 检查需要的链上访问能力，*ethclient.Client 和 simulated 后端都满足
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Env 检查使用的链和账户
+type Env struct {
+	Backend Backend
+	// simulated 后端每发一笔交易后出块，真实链上为 nil
+	Commit func()
+	// Owner 持有初始代币，Spender 被授权代扣，Recipient 接收代币，三者必须不同且都有 ETH 付 gas
+	Owner     *bind.TransactOpts
+	Spender   *bind.TransactOpts
+	Recipient *bind.TransactOpts
+}
+
+// Checker 对一个合约执行全部场景
+type Checker struct {
+	ctx    context.Context
+	env    *Env
+	token  Token
+	events *genCode.IERC20Filterer
+	report *Report
+}
+
+// Run 执行全部场景并返回报告，error 只表示链访问失败，合约的不一致记录在报告中
+func Run(ctx context.Context, env *Env, token Token) (*Report, error) {
+	events, err := genCode.NewIERC20Filterer(token.Address(), env.Backend)
+	if err != nil {
+		return nil, err
+	}
+	c := &Checker{
+		ctx:    ctx,
+		env:    env,
+		token:  token,
+		events: events,
+		report: &Report{Token: token.Address()},
+	}
+	for _, s := range scenarios {
+		if err := s.run(c); err != nil {
+			return c.report, fmt.Errorf("场景 %s: %w", s.name, err)
+		}
+	}
+	return c.report, nil
+}
+
+// record 记录一条检查结果
+func (c *Checker) record(scenario, rule string, severity Severity, passed bool, format string, args ...interface{}) {
+	c.report.Results = append(c.report.Results, Result{
+		Scenario: scenario,
+		Rule:     rule,
+		Severity: severity,
+		Passed:   passed,
+		Detail:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *Checker) callOpts() *bind.CallOpts {
+	return &bind.CallOpts{Context: c.ctx}
+}
+
+// outcome 一笔交易的执行结果
+type outcome struct {
+	receipt *types.Receipt
+	// 发送前估算 gas 失败或上链后执行失败
+	reverted bool
+	reason   string
+}
+
+// send 发送一笔交易并等待上链，合约 revert 不算 error
+func (c *Checker) send(opts *bind.TransactOpts, fn func(opts *bind.TransactOpts) (*types.Transaction, error)) (*outcome, error) {
+	sendOpts := *opts
+	sendOpts.Context = c.ctx
+	tx, err := fn(&sendOpts)
+	if errors.Is(err, ErrUnsupported) {
+		return nil, err
+	}
+	if err != nil {
+		// 估算 gas 时的 revert 直接返回，不会上链
+		return &outcome{reverted: true, reason: err.Error()}, nil
+	}
+	if c.env.Commit != nil {
+		c.env.Commit()
+	}
+	receipt, err := bind.WaitMined(c.ctx, c.env.Backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return &outcome{receipt: receipt, reverted: true, reason: "交易执行失败"}, nil
+	}
+	return &outcome{receipt: receipt}, nil
+}
+
+// transfers 收据中由被检查合约发出的 Transfer 事件
+func (c *Checker) transfers(receipt *types.Receipt) []*genCode.IERC20Transfer {
+	var events []*genCode.IERC20Transfer
+	for _, l := range receipt.Logs {
+		if l.Address != c.token.Address() {
+			continue
+		}
+		if event, err := c.events.ParseTransfer(*l); err == nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// approvals 收据中由被检查合约发出的 Approval 事件
+func (c *Checker) approvals(receipt *types.Receipt) []*genCode.IERC20Approval {
+	var events []*genCode.IERC20Approval
+	for _, l := range receipt.Logs {
+		if l.Address != c.token.Address() {
+			continue
+		}
+		if event, err := c.events.ParseApproval(*l); err == nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// balances 依次查询多个账户的余额
+func (c *Checker) balances(accounts ...common.Address) ([]*big.Int, error) {
+	result := make([]*big.Int, len(accounts))
+	for i, account := range accounts {
+		balance, err := c.token.BalanceOf(c.callOpts(), account)
+		if err != nil {
+			return nil, fmt.Errorf("查询 %s 余额失败: %w", account.Hex(), err)
+		}
+		result[i] = balance
+	}
+	return result, nil
+}
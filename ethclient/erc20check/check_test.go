@@ -0,0 +1,72 @@
+package erc20check
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"ethclient/devchain"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// erc201Deviations Erc201.sol 已知的和 EIP-20 不一致的地方，格式为 scenario/rule
+// 合约源码修改后需要在 genCode 目录运行 go generate 重新生成绑定，并核对这里期望的不一致项
+var erc201Deviations = []string{
+	// 授权接口是 approve(owner, spender, amount)，没有 allowance 查询
+	"abi/function allowance(address,address)",
+	"abi/function approve(address,uint256)",
+	"approve/allowance 返回授权额度",
+	// 构造函数先把 _totalSupply 设为 1000000，再 mint 1000000 * 10**18
+	"total-supply/创建代币时触发 from 为零地址的 Transfer 事件",
+	// 要求 amount > 0
+	"transfer-zero-value/转账成功",
+	// 要求授权额度不超过余额
+	"approve-exceeds-balance/允许授权超过余额的额度",
+	// 任何人都可以替 owner 授权
+	"approve-on-behalf/只有 owner 本人可以授权(非标准 approve(address,address,uint256))",
+	// transferFrom 把剩余额度授权给了 to，而不是扣减 msg.sender 的额度
+	"transfer-from/Approval 事件的 spender 是 msg.sender",
+	"transfer-from/transferFrom 扣减 msg.sender 的授权额度",
+	"transfer-from/代扣超过剩余授权额度时失败",
+	"transfer-from-without-allowance/未授权的账户不能代扣",
+}
+
+func TestRunErc201(t *testing.T) {
+	parsed, err := genCode.Erc201MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chain, err := devchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	env := NewSimulated(chain)
+	ctx := context.Background()
+	address, _, err := chain.Deploy(ctx, env.Owner, parsed, common.FromHex(genCode.Erc201MetaData.Bin), "Erc201", "E201")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := NewErc201(address, env.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := Run(ctx, env, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, result := range report.Deviations() {
+		got = append(got, result.Scenario+"/"+result.Rule)
+	}
+	want := append([]string(nil), erc201Deviations...)
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Fatalf("不一致项:\n got %q\nwant %q", got, want)
+	}
+}
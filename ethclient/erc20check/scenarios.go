@@ -0,0 +1,455 @@
+package erc20check
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// 场景中使用的转账/授权数量(最小单位)，Owner 余额至少要有 minOwnerBalance
+var (
+	amount          = big.NewInt(1000)
+	minOwnerBalance = big.NewInt(10000)
+)
+
+type scenario struct {
+	name string
+	run  func(c *Checker) error
+}
+
+// scenarios 按顺序执行，后面的场景依赖前面留下的余额和授权
+var scenarios = []scenario{
+	{"abi", checkABI},
+	{"total-supply", checkTotalSupply},
+	{"transfer", checkTransfer},
+	{"transfer-zero-value", checkTransferZeroValue},
+	{"transfer-exceeds-balance", checkTransferExceedsBalance},
+	{"transfer-to-zero-address", checkTransferToZeroAddress},
+	{"approve", checkApprove},
+	{"approve-exceeds-balance", checkApproveExceedsBalance},
+	{"approve-zero-spender", checkApproveZeroSpender},
+	{"approve-on-behalf", checkApproveOnBehalf},
+	{"transfer-from", checkTransferFrom},
+	{"transfer-from-without-allowance", checkTransferFromWithoutAllowance},
+}
+
+// checkABI 方法和事件签名必须和 EIP-20 一致，钱包和交易所按标准选择器调用
+func checkABI(c *Checker) error {
+	standard, err := genCode.IERC20MetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	actual := c.token.ABI()
+	for _, name := range sortedMethods(standard) {
+		want := standard.Methods[name]
+		got, ok := findMethod(actual, want.Sig)
+		switch {
+		case !ok:
+			c.record("abi", "function "+want.Sig, SeverityMust, false, "缺少该方法%s", similar(actual, name))
+		case typesOf(got.Outputs) != typesOf(want.Outputs):
+			c.record("abi", "function "+want.Sig, SeverityMust, false, "返回值为 (%s)，应为 (%s)", typesOf(got.Outputs), typesOf(want.Outputs))
+		default:
+			c.record("abi", "function "+want.Sig, SeverityMust, true, "")
+		}
+	}
+	for _, name := range []string{"Transfer", "Approval"} {
+		want := standard.Events[name]
+		got, ok := actual.Events[name]
+		switch {
+		case !ok || got.Sig != want.Sig:
+			c.record("abi", "event "+want.Sig, SeverityMust, false, "缺少该事件")
+		case indexedOf(got.Inputs) != indexedOf(want.Inputs):
+			c.record("abi", "event "+want.Sig, SeverityMust, false, "indexed 参数为 %s，应为 %s", indexedOf(got.Inputs), indexedOf(want.Inputs))
+		default:
+			c.record("abi", "event "+want.Sig, SeverityMust, true, "")
+		}
+	}
+	return nil
+}
+
+// checkTotalSupply totalSupply 必须和 mint/burn 的 Transfer 事件对得上
+func checkTotalSupply(c *Checker) error {
+	balances, err := c.balances(c.env.Owner.From, c.env.Spender.From, c.env.Recipient.From)
+	if err != nil {
+		return err
+	}
+	if balances[0].Cmp(minOwnerBalance) < 0 {
+		return fmt.Errorf("Owner %s 余额 %s 不足 %s，无法执行后续场景", c.env.Owner.From.Hex(), balances[0], minOwnerBalance)
+	}
+	supply, err := c.token.TotalSupply(c.callOpts())
+	if err != nil {
+		// 合约没有 totalSupply 时调用会失败，记录后继续其他场景
+		c.record("total-supply", "totalSupply 可以调用", SeverityMust, false, "%v", err)
+		return nil
+	}
+	held := new(big.Int)
+	for _, balance := range balances {
+		held.Add(held, balance)
+	}
+	c.record("total-supply", "totalSupply 不小于账户余额之和", SeverityMust, supply.Cmp(held) >= 0,
+		"totalSupply %s，已知账户余额之和 %s", supply, held)
+
+	// 从零地址转出视为增发，转入零地址视为销毁
+	minted, err := c.sumTransfers(true)
+	if err != nil {
+		return err
+	}
+	burned, err := c.sumTransfers(false)
+	if err != nil {
+		return err
+	}
+	issued := new(big.Int).Sub(minted, burned)
+	c.record("total-supply", "创建代币时触发 from 为零地址的 Transfer 事件", SeverityShould, supply.Cmp(issued) == 0,
+		"totalSupply %s，Transfer 事件记录的发行量 %s", supply, issued)
+	return nil
+}
+
+// sumTransfers 累加从零地址转出(mint 为 true)或转入零地址的数量
+func (c *Checker) sumTransfers(mint bool) (*big.Int, error) {
+	var from, to []common.Address
+	if mint {
+		from = []common.Address{{}}
+	} else {
+		to = []common.Address{{}}
+	}
+	iter, err := c.events.FilterTransfer(&bind.FilterOpts{Context: c.ctx}, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	total := new(big.Int)
+	for iter.Next() {
+		total.Add(total, iter.Event.Value)
+	}
+	return total, iter.Error()
+}
+
+// checkTransfer 正常转账：返回成功、余额变化正确并触发 Transfer 事件
+func checkTransfer(c *Checker) error {
+	owner, recipient := c.env.Owner.From, c.env.Recipient.From
+	return c.expectTransfer("transfer", c.env.Owner, owner, recipient, amount, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.token.Transfer(opts, recipient, amount)
+	})
+}
+
+// checkTransferZeroValue EIP-20: Transfers of 0 values MUST be treated as normal transfers and fire the Transfer event
+func checkTransferZeroValue(c *Checker) error {
+	owner, recipient := c.env.Owner.From, c.env.Recipient.From
+	zero := new(big.Int)
+	return c.expectTransfer("transfer-zero-value", c.env.Owner, owner, recipient, zero, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.token.Transfer(opts, recipient, zero)
+	})
+}
+
+// checkTransferExceedsBalance EIP-20: The function SHOULD throw if the message caller's account balance does not have enough tokens
+func checkTransferExceedsBalance(c *Checker) error {
+	owner, recipient := c.env.Owner.From, c.env.Recipient.From
+	before, err := c.balances(recipient, owner)
+	if err != nil {
+		return err
+	}
+	value := new(big.Int).Add(before[0], big.NewInt(1))
+	return c.expectRevert("transfer-exceeds-balance", "余额不足时 transfer 失败", SeverityShould, c.env.Recipient,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.token.Transfer(opts, owner, value)
+		}, recipient, owner)
+}
+
+// checkTransferToZeroAddress 转给零地址会永久销毁代币，主流实现都会拒绝
+func checkTransferToZeroAddress(c *Checker) error {
+	owner := c.env.Owner.From
+	return c.expectRevert("transfer-to-zero-address", "拒绝转账到零地址", SeverityConvention, c.env.Owner,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.token.Transfer(opts, common.Address{}, amount)
+		}, owner)
+}
+
+// checkApprove 授权成功、触发 Approval 事件并能通过 allowance 查询
+func checkApprove(c *Checker) error {
+	return c.expectApprove("approve", amount)
+}
+
+// checkApproveExceedsBalance 授权额度可以超过余额，常见的无限授权(2^256-1)依赖这一点
+func checkApproveExceedsBalance(c *Checker) error {
+	spender := c.env.Spender.From
+	result, err := c.send(c.env.Owner, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.token.Approve(opts, spender, math.MaxBig256)
+	})
+	if err != nil {
+		return err
+	}
+	c.record("approve-exceeds-balance", "允许授权超过余额的额度", SeverityConvention, !result.reverted, "%s", result.reason)
+	// 恢复为 approve 场景的额度，供 transfer-from 使用
+	_, err = c.approve(c.env.Owner, spender, amount)
+	return err
+}
+
+// checkApproveZeroSpender 授权给零地址没有意义，主流实现都会拒绝
+func checkApproveZeroSpender(c *Checker) error {
+	return c.expectRevert("approve-zero-spender", "拒绝授权给零地址", SeverityConvention, c.env.Owner,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.token.Approve(opts, common.Address{}, amount)
+		})
+}
+
+// checkApproveOnBehalf 非标准的 approve(owner, spender, amount) 不能让任何人替 owner 授权
+func checkApproveOnBehalf(c *Checker) error {
+	method, ok := approveOnBehalf(c.token.ABI())
+	if !ok {
+		return nil
+	}
+	owner, spender := c.env.Owner.From, c.env.Spender.From
+	contract := bind.NewBoundContract(c.token.Address(), *c.token.ABI(), c.env.Backend, c.env.Backend, c.env.Backend)
+	value := new(big.Int).Mul(amount, big.NewInt(2))
+	err := c.expectRevert("approve-on-behalf", "只有 owner 本人可以授权(非标准 "+method.Sig+")", SeverityMust, c.env.Spender,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return contract.Transact(opts, method.Name, owner, spender, value)
+		})
+	if err != nil {
+		return err
+	}
+	// 恢复为 approve 场景的额度，供 transfer-from 使用
+	_, err = c.approve(c.env.Owner, spender, amount)
+	return err
+}
+
+// checkTransferFrom 代扣转账必须扣减 msg.sender 的授权额度，额度用完后再代扣必须失败
+func checkTransferFrom(c *Checker) error {
+	owner, spender, recipient := c.env.Owner.From, c.env.Spender.From, c.env.Recipient.From
+	half := new(big.Int).Div(amount, big.NewInt(2))
+	rest := new(big.Int).Sub(amount, half)
+
+	spend := func(value *big.Int) func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.token.TransferFrom(opts, owner, recipient, value)
+		}
+	}
+	ok, err := c.approve(c.env.Owner, spender, amount)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		c.record("transfer-from", "代扣转账", SeverityMust, false, "无法通过 approve 授权，跳过 transferFrom 检查")
+		return nil
+	}
+	if err := c.expectTransfer("transfer-from", c.env.Spender, owner, recipient, half, spend(half)); err != nil {
+		return err
+	}
+	if err := c.expectAllowance("transfer-from", "transferFrom 扣减 msg.sender 的授权额度", owner, spender, rest); err != nil {
+		return err
+	}
+
+	// 超过剩余额度
+	over := new(big.Int).Add(rest, big.NewInt(1))
+	if err := c.expectRevert("transfer-from", "代扣超过剩余授权额度时失败", SeverityShould, c.env.Spender, spend(over), owner, recipient); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkTransferFromWithoutAllowance EIP-20: The function SHOULD throw unless the _from account has deliberately authorized the sender
+func checkTransferFromWithoutAllowance(c *Checker) error {
+	owner, recipient := c.env.Owner.From, c.env.Recipient.From
+	// Recipient 从未被 Owner 授权，但收到过 transferFrom 转来的代币
+	return c.expectRevert("transfer-from-without-allowance", "未授权的账户不能代扣", SeverityShould, c.env.Recipient,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return c.token.TransferFrom(opts, owner, recipient, big.NewInt(1))
+		}, owner, recipient)
+}
+
+// expectTransfer 发送一笔转账，检查执行成功、余额变化和 Transfer 事件
+func (c *Checker) expectTransfer(scenario string, sender *bind.TransactOpts, from, to common.Address, value *big.Int,
+	fn func(opts *bind.TransactOpts) (*types.Transaction, error)) error {
+	before, err := c.balances(from, to)
+	if err != nil {
+		return err
+	}
+	result, err := c.send(sender, fn)
+	if err != nil {
+		return err
+	}
+	c.record(scenario, "转账成功", SeverityMust, !result.reverted, "%s", result.reason)
+	if result.reverted {
+		return nil
+	}
+
+	after, err := c.balances(from, to)
+	if err != nil {
+		return err
+	}
+	fromDelta := new(big.Int).Sub(before[0], after[0])
+	toDelta := new(big.Int).Sub(after[1], before[1])
+	c.record(scenario, "余额变化等于转账数量", SeverityMust, fromDelta.Cmp(value) == 0 && toDelta.Cmp(value) == 0,
+		"from 减少 %s，to 增加 %s，转账数量 %s", fromDelta, toDelta, value)
+
+	matched := false
+	for _, event := range c.transfers(result.receipt) {
+		if event.From == from && event.To == to && event.Value.Cmp(value) == 0 {
+			matched = true
+		}
+	}
+	c.record(scenario, "触发 Transfer 事件", SeverityMust, matched, "Transfer(%s, %s, %s)", from.Hex(), to.Hex(), value)
+
+	c.checkApprovalEvents(scenario, sender.From, result.receipt)
+	return nil
+}
+
+// checkApprovalEvents 转账中如果触发 Approval 事件，spender 必须是 msg.sender
+func (c *Checker) checkApprovalEvents(scenario string, sender common.Address, receipt *types.Receipt) {
+	for _, event := range c.approvals(receipt) {
+		c.record(scenario, "Approval 事件的 spender 是 msg.sender", SeverityShould, event.Spender == sender,
+			"Approval(%s, %s, %s)", event.Owner.Hex(), event.Spender.Hex(), event.Value)
+	}
+}
+
+// expectRevert 交易必须失败，并且 unchanged 中账户的余额不能变化
+func (c *Checker) expectRevert(scenario, rule string, severity Severity, sender *bind.TransactOpts,
+	fn func(opts *bind.TransactOpts) (*types.Transaction, error), unchanged ...common.Address) error {
+	before, err := c.balances(unchanged...)
+	if err != nil {
+		return err
+	}
+	result, err := c.send(sender, fn)
+	if err != nil {
+		return err
+	}
+	if result.reverted {
+		c.record(scenario, rule, severity, true, "")
+		return nil
+	}
+	after, err := c.balances(unchanged...)
+	if err != nil {
+		return err
+	}
+	var changed []string
+	for i, account := range unchanged {
+		if before[i].Cmp(after[i]) != 0 {
+			changed = append(changed, fmt.Sprintf("%s: %s 变为 %s", account.Hex(), before[i], after[i]))
+		}
+	}
+	detail := "交易执行成功"
+	if len(changed) > 0 {
+		detail += "，余额变化 " + strings.Join(changed, "; ")
+	}
+	c.record(scenario, rule, severity, false, "%s (tx %s)", detail, result.receipt.TxHash.Hex())
+	return nil
+}
+
+// expectApprove Owner 授权 Spender，检查执行成功、Approval 事件和 allowance
+func (c *Checker) expectApprove(scenario string, value *big.Int) error {
+	owner, spender := c.env.Owner.From, c.env.Spender.From
+	result, err := c.send(c.env.Owner, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.token.Approve(opts, spender, value)
+	})
+	if err != nil {
+		return err
+	}
+	c.record(scenario, "授权成功", SeverityMust, !result.reverted, "%s", result.reason)
+	if result.reverted {
+		return nil
+	}
+
+	matched := false
+	for _, event := range c.approvals(result.receipt) {
+		if event.Owner == owner && event.Spender == spender && event.Value.Cmp(value) == 0 {
+			matched = true
+		}
+	}
+	c.record(scenario, "触发 Approval 事件", SeverityMust, matched, "Approval(%s, %s, %s)", owner.Hex(), spender.Hex(), value)
+	return c.expectAllowance(scenario, "allowance 返回授权额度", owner, spender, value)
+}
+
+// expectAllowance allowance(owner, spender) 必须等于 want
+func (c *Checker) expectAllowance(scenario, rule string, owner, spender common.Address, want *big.Int) error {
+	got, err := c.token.Allowance(c.callOpts(), owner, spender)
+	if errors.Is(err, ErrUnsupported) {
+		c.record(scenario, rule, SeverityMust, false, "合约没有 allowance(address,address) 方法，无法查询授权额度")
+		return nil
+	}
+	if err != nil {
+		c.record(scenario, rule, SeverityMust, false, "调用 allowance 失败: %v", err)
+		return nil
+	}
+	c.record(scenario, rule, SeverityMust, got.Cmp(want) == 0, "allowance %s，应为 %s", got, want)
+	return nil
+}
+
+// approve 设置授权额度供后续场景使用，授权失败时返回 false，失败原因已由 approve 场景记录
+func (c *Checker) approve(owner *bind.TransactOpts, spender common.Address, value *big.Int) (bool, error) {
+	result, err := c.send(owner, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.token.Approve(opts, spender, value)
+	})
+	if err != nil {
+		return false, err
+	}
+	return !result.reverted, nil
+}
+
+// approveOnBehalf 查找 approve(address owner, address spender, uint256) 形式的非标准方法
+func approveOnBehalf(parsed *abi.ABI) (abi.Method, bool) {
+	for _, method := range parsed.Methods {
+		if method.RawName == "approve" && method.Sig == "approve(address,address,uint256)" {
+			return method, true
+		}
+	}
+	return abi.Method{}, false
+}
+
+// findMethod 按签名查找方法，重载方法的 Name 会带后缀，所以不能直接按名字查
+func findMethod(parsed *abi.ABI, sig string) (abi.Method, bool) {
+	for _, method := range parsed.Methods {
+		if method.Sig == sig {
+			return method, true
+		}
+	}
+	return abi.Method{}, false
+}
+
+// similar 列出同名但签名不同的方法，方便定位问题
+func similar(parsed *abi.ABI, name string) string {
+	var sigs []string
+	for _, method := range parsed.Methods {
+		if method.RawName == name {
+			sigs = append(sigs, method.Sig)
+		}
+	}
+	if len(sigs) == 0 {
+		return ""
+	}
+	return "，合约中为 " + strings.Join(sigs, ", ")
+}
+
+func sortedMethods(parsed *abi.ABI) []string {
+	names := make([]string, 0, len(parsed.Methods))
+	for name := range parsed.Methods {
+		names = append(names, name)
+	}
+	// 固定输出顺序，方便对比两次报告
+	sort.Strings(names)
+	return names
+}
+
+func typesOf(args abi.Arguments) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type.String()
+	}
+	return strings.Join(types, ",")
+}
+
+func indexedOf(args abi.Arguments) string {
+	flags := make([]string, len(args))
+	for i, arg := range args {
+		flags[i] = fmt.Sprintf("%s:%t", arg.Type.String(), arg.Indexed)
+	}
+	return strings.Join(flags, ",")
+}
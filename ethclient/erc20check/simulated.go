@@ -0,0 +1,16 @@
+package erc20check
+
+import (
+	"ethclient/devchain"
+)
+
+// NewSimulated 使用内存链的前三个账户作为 Owner/Spender/Recipient，合约需要由 Owner 部署
+func NewSimulated(chain *devchain.Chain) *Env {
+	return &Env{
+		Backend:   chain.Client,
+		Commit:    chain.Commit,
+		Owner:     chain.Accounts[0],
+		Spender:   chain.Accounts[1],
+		Recipient: chain.Accounts[2],
+	}
+}
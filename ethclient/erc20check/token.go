@@ -0,0 +1,91 @@
+package erc20check
+
+import (
+	"errors"
+	"math/big"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrUnsupported 合约没有提供对应的 ERC-20 方法
+var ErrUnsupported = errors.New("合约没有实现该方法")
+
+// Token 被检查的 ERC-20 合约，方法签名和 EIP-20 一致
+// 非标准合约通过适配器把自己的方法映射过来，映射不了的返回 ErrUnsupported
+type Token interface {
+	Address() common.Address
+	// ABI 合约实际的 ABI，用于检查方法签名
+	ABI() *abi.ABI
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Allowance(opts *bind.CallOpts, owner, spender common.Address) (*big.Int, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from, to common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// standard 标准 ERC-20 合约，直接使用 IERC20 绑定
+type standard struct {
+	*genCode.IERC20
+	address common.Address
+	abi     *abi.ABI
+}
+
+// NewStandard 绑定一个按 IERC20 接口实现的合约
+// parsed 是合约实际的 ABI(solc --abi 的输出)，为 nil 时认为合约的 ABI 就是 IERC20
+func NewStandard(address common.Address, backend bind.ContractBackend, parsed *abi.ABI) (Token, error) {
+	if parsed == nil {
+		var err error
+		if parsed, err = genCode.IERC20MetaData.GetAbi(); err != nil {
+			return nil, err
+		}
+	}
+	token, err := genCode.NewIERC20(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &standard{IERC20: token, address: address, abi: parsed}, nil
+}
+
+func (t *standard) Address() common.Address { return t.address }
+
+func (t *standard) ABI() *abi.ABI { return t.abi }
+
+// erc201 solidity_task/task2/Erc201.sol 的适配器
+//
+//	approve(owner, spender, amount) 映射为 approve(opts.From, spender, amount)
+//	没有 allowance 查询方法
+type erc201 struct {
+	*genCode.Erc201
+	address common.Address
+	abi     *abi.ABI
+}
+
+// NewErc201 绑定 Erc201 合约
+func NewErc201(address common.Address, backend bind.ContractBackend) (Token, error) {
+	parsed, err := genCode.Erc201MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	token, err := genCode.NewErc201(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &erc201{Erc201: token, address: address, abi: parsed}, nil
+}
+
+func (t *erc201) Address() common.Address { return t.address }
+
+func (t *erc201) ABI() *abi.ABI { return t.abi }
+
+func (t *erc201) Allowance(opts *bind.CallOpts, owner, spender common.Address) (*big.Int, error) {
+	return nil, ErrUnsupported
+}
+
+func (t *erc201) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return t.Erc201.Approve(opts, opts.From, spender, amount)
+}
@@ -0,0 +1,397 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"ethclient/txdecode"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+// Backend 导出需要的链上访问能力，*ethclient.Client 和 simulated 后端都满足
+type Backend interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// blockReceiptsReader 支持 eth_getBlockReceipts 的节点一次取回整个区块的回执
+type blockReceiptsReader interface {
+	BlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*types.Receipt, error)
+}
+
+// 默认每个分区的区块数和并发数
+const (
+	DefaultPartitionSize = 1000
+	DefaultWorkers       = 8
+)
+
+// Config 导出范围和输出设置
+type Config struct {
+	Dir  string
+	From uint64
+	// 为 0 时导出到最新区块
+	To uint64
+	// 分区按区块号对齐: [k*PartitionSize, (k+1)*PartitionSize-1]
+	PartitionSize uint64
+	Formats       []Format
+	Workers       int
+}
+
+// Summary 一次导出的统计
+type Summary struct {
+	From         uint64 `json:"from"`
+	To           uint64 `json:"to"`
+	Partitions   int    `json:"partitions"`
+	Skipped      int    `json:"skipped"`
+	Blocks       int    `json:"blocks"`
+	Transactions int    `json:"transactions"`
+	Logs         int    `json:"logs"`
+}
+
+// marker 分区完成标记，记录已导出的区块范围和格式
+type marker struct {
+	From         uint64   `json:"from"`
+	To           uint64   `json:"to"`
+	Formats      []Format `json:"formats"`
+	Blocks       int      `json:"blocks"`
+	Transactions int      `json:"transactions"`
+	Logs         int      `json:"logs"`
+}
+
+// Exporter 按分区导出区块数据
+type Exporter struct {
+	backend  Backend
+	registry *txdecode.Registry
+	cfg      Config
+	signer   types.Signer
+}
+
+// NewExporter registry 用于解码交易方法和日志，为 nil 时不解码
+func NewExporter(backend Backend, registry *txdecode.Registry, cfg Config) (*Exporter, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("需要输出目录")
+	}
+	if cfg.PartitionSize == 0 {
+		cfg.PartitionSize = DefaultPartitionSize
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = DefaultWorkers
+	}
+	if len(cfg.Formats) == 0 {
+		cfg.Formats = []Format{FormatCSV, FormatParquet}
+	}
+	return &Exporter{backend: backend, registry: registry, cfg: cfg}, nil
+}
+
+// Run 导出 [From, To]，已有完成标记且覆盖所需范围的分区直接跳过
+func (e *Exporter) Run(ctx context.Context) (*Summary, error) {
+	chainID, err := e.backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.signer = types.LatestSignerForChainID(chainID)
+
+	to := e.cfg.To
+	if to == 0 {
+		if to, err = e.backend.BlockNumber(ctx); err != nil {
+			return nil, fmt.Errorf("获取最新区块失败: %w", err)
+		}
+	}
+	if e.cfg.From > to {
+		return nil, fmt.Errorf("起始区块 %d 大于结束区块 %d", e.cfg.From, to)
+	}
+
+	summary := &Summary{From: e.cfg.From, To: to}
+	size := e.cfg.PartitionSize
+	for start := e.cfg.From / size * size; start <= to; start += size {
+		end := start + size - 1
+		from, until := max(start, e.cfg.From), min(end, to)
+		summary.Partitions++
+
+		done, err := e.readMarker(start, end)
+		if err != nil {
+			return summary, err
+		}
+		if done != nil {
+			if done.From <= from && done.To >= until && e.hasFormats(done) {
+				summary.Skipped++
+				continue
+			}
+			// 重新导出时合并之前导出过的范围，不丢失已有数据
+			from, until = min(from, done.From), max(until, done.To)
+		}
+
+		m, err := e.exportPartition(ctx, start, end, from, until)
+		if err != nil {
+			return summary, err
+		}
+		summary.Blocks += m.Blocks
+		summary.Transactions += m.Transactions
+		summary.Logs += m.Logs
+		log.Printf("已导出区块 %d-%d: %d 笔交易, %d 条日志", from, until, m.Transactions, m.Logs)
+	}
+	return summary, nil
+}
+
+// rows 一个分区的全部数据
+type rows struct {
+	blocks       []Block
+	transactions []Transaction
+	receipts     []Receipt
+	logs         []Log
+}
+
+// exportPartition 并发获取 [from, until] 的区块，按区块号顺序写入分区文件，最后写完成标记
+func (e *Exporter) exportPartition(ctx context.Context, start, end, from, until uint64) (*marker, error) {
+	results := make([]*rows, until-from+1)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(e.cfg.Workers)
+	for number := from; number <= until; number++ {
+		number := number
+		group.Go(func() error {
+			r, err := e.fetch(groupCtx, number)
+			if err != nil {
+				return fmt.Errorf("获取区块 %d 失败: %w", number, err)
+			}
+			results[number-from] = r
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	var all rows
+	for _, r := range results {
+		all.blocks = append(all.blocks, r.blocks...)
+		all.transactions = append(all.transactions, r.transactions...)
+		all.receipts = append(all.receipts, r.receipts...)
+		all.logs = append(all.logs, r.logs...)
+	}
+	for _, format := range e.cfg.Formats {
+		if err := writeTable(e.path(TableBlocks, start, end, format), format, all.blocks); err != nil {
+			return nil, err
+		}
+		if err := writeTable(e.path(TableTransactions, start, end, format), format, all.transactions); err != nil {
+			return nil, err
+		}
+		if err := writeTable(e.path(TableReceipts, start, end, format), format, all.receipts); err != nil {
+			return nil, err
+		}
+		if err := writeTable(e.path(TableLogs, start, end, format), format, all.logs); err != nil {
+			return nil, err
+		}
+	}
+
+	m := &marker{
+		From:         from,
+		To:           until,
+		Formats:      e.cfg.Formats,
+		Blocks:       len(all.blocks),
+		Transactions: len(all.transactions),
+		Logs:         len(all.logs),
+	}
+	return m, e.writeMarker(start, end, m)
+}
+
+// fetch 获取一个区块及其交易回执
+func (e *Exporter) fetch(ctx context.Context, number uint64) (*rows, error) {
+	block, err := e.backend.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := e.receipts(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &rows{blocks: []Block{{
+		Number:     number,
+		Hash:       block.Hash().Hex(),
+		ParentHash: block.ParentHash().Hex(),
+		Timestamp:  block.Time(),
+		Miner:      block.Coinbase().Hex(),
+		GasLimit:   block.GasLimit(),
+		GasUsed:    block.GasUsed(),
+		BaseFee:    bigString(block.BaseFee()),
+		TxCount:    len(block.Transactions()),
+		Size:       block.Size(),
+	}}}
+	for i, tx := range block.Transactions() {
+		from, err := types.Sender(e.signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("获取交易 %s 发送者失败: %w", tx.Hash().Hex(), err)
+		}
+		row := Transaction{
+			BlockNumber: number,
+			Index:       uint64(i),
+			Hash:        tx.Hash().Hex(),
+			From:        from.Hex(),
+			Value:       tx.Value().String(),
+			Nonce:       tx.Nonce(),
+			Type:        uint32(tx.Type()),
+			Gas:         tx.Gas(),
+			GasPrice:    tx.GasPrice().String(),
+			Input:       hexutil.Encode(tx.Data()),
+		}
+		if tx.To() != nil {
+			row.To = tx.To().Hex()
+		}
+		if tx.Type() >= types.DynamicFeeTxType {
+			row.MaxFeePerGas = tx.GasFeeCap().String()
+			row.MaxPriorityFeePerGas = tx.GasTipCap().String()
+		}
+		if e.registry != nil && len(tx.Data()) >= 4 {
+			if call, err := e.registry.DecodeCalldata(tx.Data()); err == nil {
+				row.Method = call.Contract + "." + call.Signature
+			}
+		}
+		r.transactions = append(r.transactions, row)
+
+		receipt := receipts[i]
+		receiptRow := Receipt{
+			BlockNumber:       number,
+			TxIndex:           uint64(i),
+			TxHash:            tx.Hash().Hex(),
+			Status:            receipt.Status,
+			GasUsed:           receipt.GasUsed,
+			CumulativeGasUsed: receipt.CumulativeGasUsed,
+			EffectiveGasPrice: bigString(receipt.EffectiveGasPrice),
+			LogCount:          len(receipt.Logs),
+		}
+		if receipt.ContractAddress != (common.Address{}) {
+			receiptRow.ContractAddress = receipt.ContractAddress.Hex()
+		}
+		r.receipts = append(r.receipts, receiptRow)
+
+		for _, l := range receipt.Logs {
+			logRow, err := e.logRow(number, uint64(i), l)
+			if err != nil {
+				return nil, err
+			}
+			r.logs = append(r.logs, logRow)
+		}
+	}
+	return r, nil
+}
+
+// receipts 节点支持时用 eth_getBlockReceipts，否则逐笔查询
+func (e *Exporter) receipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+	if reader, ok := e.backend.(blockReceiptsReader); ok {
+		receipts, err := reader.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+		if err == nil && len(receipts) == len(block.Transactions()) {
+			return receipts, nil
+		}
+	}
+	receipts := make([]*types.Receipt, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		receipt, err := e.backend.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("获取交易 %s 的回执失败: %w", tx.Hash().Hex(), err)
+		}
+		receipts[i] = receipt
+	}
+	return receipts, nil
+}
+
+func (e *Exporter) logRow(number, txIndex uint64, l *types.Log) (Log, error) {
+	row := Log{
+		BlockNumber: number,
+		TxIndex:     txIndex,
+		TxHash:      l.TxHash.Hex(),
+		LogIndex:    uint64(l.Index),
+		Address:     l.Address.Hex(),
+		Data:        hexutil.Encode(l.Data),
+	}
+	topics := []*string{&row.Topic0, &row.Topic1, &row.Topic2, &row.Topic3}
+	for i, topic := range l.Topics {
+		if i < len(topics) {
+			*topics[i] = topic.Hex()
+		}
+	}
+	if e.registry == nil {
+		return row, nil
+	}
+	// 解码失败(如 topic 数量和已知事件不一致)时只输出原始数据
+	decoded, err := e.registry.DecodeLog(*l)
+	if err != nil || decoded == nil {
+		return row, nil
+	}
+	args, err := json.Marshal(decoded.Args)
+	if err != nil {
+		return row, err
+	}
+	row.Contract = decoded.Contract
+	row.Event = decoded.Name
+	row.Signature = decoded.Signature
+	row.Args = string(args)
+	return row, nil
+}
+
+// path 分区文件路径，如 blocks/0000001000-0000001999.csv
+func (e *Exporter) path(table string, start, end uint64, format Format) string {
+	return filepath.Join(e.cfg.Dir, table, fmt.Sprintf("%010d-%010d.%s", start, end, format))
+}
+
+func (e *Exporter) markerPath(start, end uint64) string {
+	return filepath.Join(e.cfg.Dir, "_done", fmt.Sprintf("%010d-%010d.json", start, end))
+}
+
+func (e *Exporter) readMarker(start, end uint64) (*marker, error) {
+	data, err := os.ReadFile(e.markerPath(start, end))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m marker
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("解析完成标记 %s 失败: %w", e.markerPath(start, end), err)
+	}
+	return &m, nil
+}
+
+// writeMarker 所有表写完之后才写标记，中断的分区下次会重新导出
+func (e *Exporter) writeMarker(start, end uint64, m *marker) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := e.markerPath(start, end)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func (e *Exporter) hasFormats(m *marker) bool {
+	for _, format := range e.cfg.Formats {
+		if !slices.Contains(m.Formats, format) {
+			return false
+		}
+	}
+	return true
+}
+
+func bigString(n *big.Int) string {
+	if n == nil {
+		return ""
+	}
+	return n.String()
+}
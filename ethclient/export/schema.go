@@ -0,0 +1,89 @@
+package export
+
+/**
+链上数据导出
+    把区块范围内的区块、交易、回执和解码后的日志写成按区块分区的 CSV / Parquet 文件，供表格和分析工具使用
+    每个分区写完后记录完成标记，中断后重新执行会跳过已完成的分区
+    分区内的区块并发获取
+*/
+
+// 表结构由下面的结构体定义，列顺序即字段顺序，CSV 和 Parquet 使用相同的列名
+// 金额等可能超过 64 位的整数输出为十进制字符串，地址、哈希为 0x 开头的十六进制
+// 新增列只能加在末尾，已有列不改名、不改类型
+
+// Block blocks 表
+type Block struct {
+	Number     uint64 `parquet:"number"`
+	Hash       string `parquet:"hash"`
+	ParentHash string `parquet:"parent_hash"`
+	Timestamp  uint64 `parquet:"timestamp"`
+	Miner      string `parquet:"miner"`
+	GasLimit   uint64 `parquet:"gas_limit"`
+	GasUsed    uint64 `parquet:"gas_used"`
+	// London 之前的区块为空
+	BaseFee string `parquet:"base_fee"`
+	TxCount int    `parquet:"tx_count"`
+	Size    uint64 `parquet:"size"`
+}
+
+// Transaction transactions 表
+type Transaction struct {
+	BlockNumber uint64 `parquet:"block_number"`
+	Index       uint64 `parquet:"tx_index"`
+	Hash        string `parquet:"hash"`
+	From        string `parquet:"from"`
+	// 创建合约的交易为空
+	To       string `parquet:"to"`
+	Value    string `parquet:"value"`
+	Nonce    uint64 `parquet:"nonce"`
+	Type     uint32 `parquet:"type"`
+	Gas      uint64 `parquet:"gas"`
+	GasPrice string `parquet:"gas_price"`
+	// EIP-1559 之前的交易为空
+	MaxFeePerGas         string `parquet:"max_fee_per_gas"`
+	MaxPriorityFeePerGas string `parquet:"max_priority_fee_per_gas"`
+	Input                string `parquet:"input"`
+	// 按已知 ABI 解码出的方法，无法解码时为空
+	Method string `parquet:"method"`
+}
+
+// Receipt receipts 表
+type Receipt struct {
+	BlockNumber       uint64 `parquet:"block_number"`
+	TxIndex           uint64 `parquet:"tx_index"`
+	TxHash            string `parquet:"tx_hash"`
+	Status            uint64 `parquet:"status"`
+	GasUsed           uint64 `parquet:"gas_used"`
+	CumulativeGasUsed uint64 `parquet:"cumulative_gas_used"`
+	EffectiveGasPrice string `parquet:"effective_gas_price"`
+	// 创建合约的交易才有
+	ContractAddress string `parquet:"contract_address"`
+	LogCount        int    `parquet:"log_count"`
+}
+
+// Log logs 表
+type Log struct {
+	BlockNumber uint64 `parquet:"block_number"`
+	TxIndex     uint64 `parquet:"tx_index"`
+	TxHash      string `parquet:"tx_hash"`
+	LogIndex    uint64 `parquet:"log_index"`
+	Address     string `parquet:"address"`
+	Topic0      string `parquet:"topic0"`
+	Topic1      string `parquet:"topic1"`
+	Topic2      string `parquet:"topic2"`
+	Topic3      string `parquet:"topic3"`
+	Data        string `parquet:"data"`
+	// 按已知 ABI 解码的事件，无法解码时为空；参数为 JSON 对象
+	Contract  string `parquet:"contract"`
+	Event     string `parquet:"event"`
+	Signature string `parquet:"signature"`
+	Args      string `parquet:"args"`
+}
+
+// 导出的表名，也是输出目录名
+const (
+	TableBlocks       = "blocks"
+	TableTransactions = "transactions"
+	TableReceipts     = "receipts"
+	TableLogs         = "logs"
+)
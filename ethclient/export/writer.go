@@ -0,0 +1,104 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// Format 输出格式
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat 校验格式名
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case FormatCSV, FormatParquet:
+		return Format(s), nil
+	}
+	return "", fmt.Errorf("未知的输出格式 %s，可选 csv、parquet", s)
+}
+
+// writeTable 写入一个分区的一张表，先写临时文件再重命名，中断时不会留下不完整的文件
+func writeTable[T any](path string, format Format, rows []T) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case FormatCSV:
+		err = writeCSV(file, rows)
+	case FormatParquet:
+		err = writeParquet(file, rows)
+	default:
+		err = fmt.Errorf("未知的输出格式 %s", format)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("写入 %s 失败: %w", path, err)
+	}
+	return os.Rename(tmp, path)
+}
+
+func writeParquet[T any](file *os.File, rows []T) error {
+	writer := parquet.NewGenericWriter[T](file)
+	if _, err := writer.Write(rows); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// writeCSV 表头取字段的 parquet 标签，保证两种格式列名一致
+func writeCSV[T any](file *os.File, rows []T) error {
+	writer := csv.NewWriter(file)
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	header := make([]string, t.NumField())
+	for i := range header {
+		header[i] = t.Field(i).Tag.Get("parquet")
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	record := make([]string, len(header))
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		for i := range record {
+			record[i] = formatField(v.Field(i))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatField(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,280 @@
+package gasprof
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"ethclient/abiargs"
+	"ethclient/devchain"
+	"ethclient/units"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+合约 gas 统计
+    在内存链上部署场景文件中的合约并执行方法调用
+    按 合约.方法 统计 gas，部署记为 constructor
+    与保存的基线对比，平均 gas 上涨超过阈值时报告
+交易按收据的 gasUsed 统计，view/pure 方法按 eth_estimateGas 统计。
+
+场景文件：
+    scenario.json  Count、Store，基线为 baseline.json
+    tasks.json     Voting、BeggingContract、Erc201，字节码由 genCode 的 go generate 生成，基线为 tasks_baseline.json
+合约或编译器修改后 gas 变化是预期的，用 --save-baseline 重新保存基线。
+Auction、NftToken 依赖 OpenZeppelin 和价格预言机，部署需要 mock 合约，暂不在场景中。
+*/
+
+// Kind gas 的来源
+type Kind string
+
+const (
+	KindDeploy Kind = "deploy"
+	KindTx     Kind = "tx"
+	KindCall   Kind = "call"
+)
+
+// Entry 一个方法的 gas 统计
+type Entry struct {
+	Contract string `json:"contract"`
+	Method   string `json:"method"`
+	Kind     Kind   `json:"kind"`
+	Calls    int    `json:"calls"`
+	Min      uint64 `json:"min"`
+	Max      uint64 `json:"max"`
+	Avg      uint64 `json:"avg"`
+	total    uint64
+}
+
+func (e *Entry) add(gas uint64) {
+	if e.Calls == 0 || gas < e.Min {
+		e.Min = gas
+	}
+	if gas > e.Max {
+		e.Max = gas
+	}
+	e.Calls++
+	e.total += gas
+	e.Avg = e.total / uint64(e.Calls)
+}
+
+func (e *Entry) key() string {
+	return e.Contract + "." + e.Method
+}
+
+// Report 全部方法的 gas 统计，按场景中第一次出现的顺序排列
+type Report struct {
+	Entries []*Entry `json:"entries"`
+	index   map[string]*Entry
+}
+
+func (r *Report) record(contract, method string, kind Kind, gas uint64) {
+	if r.index == nil {
+		r.index = make(map[string]*Entry)
+	}
+	key := contract + "." + method
+	entry, ok := r.index[key]
+	if !ok {
+		entry = &Entry{Contract: contract, Method: method, Kind: kind}
+		r.index[key] = entry
+		r.Entries = append(r.Entries, entry)
+	}
+	entry.add(gas)
+}
+
+// deployed 已部署的合约
+type deployed struct {
+	address common.Address
+	abi     *abi.ABI
+	bound   *bind.BoundContract
+}
+
+// Run 在 chain 上执行场景，chain 至少要有场景中 from 用到的账户
+func Run(ctx context.Context, chain *devchain.Chain, scenario *Scenario) (*Report, error) {
+	report := &Report{}
+	contracts := make(map[string]*deployed)
+	for _, spec := range scenario.Contracts {
+		parsed, err := loadABI(spec.ABI)
+		if err != nil {
+			return nil, err
+		}
+		code, err := devchain.ReadBin(spec.Bin)
+		if err != nil {
+			return nil, err
+		}
+		args, err := abiargs.Pack(parsed.Constructor.Inputs, spec.Args)
+		if err != nil {
+			return nil, fmt.Errorf("%s 构造函数: %w", spec.Name, err)
+		}
+		address, receipt, err := chain.Deploy(ctx, chain.Accounts[0], parsed, code, args...)
+		if err != nil {
+			return nil, fmt.Errorf("部署 %s 失败: %w", spec.Name, err)
+		}
+		report.record(spec.Name, "constructor", KindDeploy, receipt.GasUsed)
+		contracts[spec.Name] = &deployed{
+			address: address,
+			abi:     parsed,
+			bound:   bind.NewBoundContract(address, *parsed, chain.Client, chain.Client, chain.Client),
+		}
+	}
+
+	for i, call := range scenario.Calls {
+		contract, ok := contracts[call.Contract]
+		if !ok {
+			return nil, fmt.Errorf("第 %d 个调用: 场景中没有合约 %s", i, call.Contract)
+		}
+		if call.From < 0 || call.From >= len(chain.Accounts) {
+			return nil, fmt.Errorf("第 %d 个调用: 没有序号为 %d 的账户", i, call.From)
+		}
+		method, ok := contract.abi.Methods[call.Method]
+		if !ok {
+			return nil, fmt.Errorf("第 %d 个调用: %s 没有方法 %s", i, call.Contract, call.Method)
+		}
+		args, err := abiargs.Pack(method.Inputs, call.Args)
+		if err != nil {
+			return nil, fmt.Errorf("第 %d 个调用 %s.%s: %w", i, call.Contract, call.Method, err)
+		}
+		value := new(big.Int)
+		if call.Value != "" {
+			if value, err = units.ParseEther(call.Value); err != nil {
+				return nil, fmt.Errorf("第 %d 个调用: %w", i, err)
+			}
+		}
+		repeat := call.Repeat
+		if repeat <= 0 {
+			repeat = 1
+		}
+		for n := 0; n < repeat; n++ {
+			kind, gas, err := execute(ctx, chain, chain.Accounts[call.From], contract, method, value, args)
+			if err != nil {
+				return nil, fmt.Errorf("第 %d 个调用 %s.%s: %w", i, call.Contract, call.Method, err)
+			}
+			report.record(call.Contract, call.Method, kind, gas)
+		}
+	}
+	return report, nil
+}
+
+// execute view/pure 方法估算 gas，其他方法发送交易后读取收据
+func execute(ctx context.Context, chain *devchain.Chain, from *bind.TransactOpts, contract *deployed,
+	method abi.Method, value *big.Int, args []interface{}) (Kind, uint64, error) {
+	if method.IsConstant() {
+		data, err := contract.abi.Pack(method.Name, args...)
+		if err != nil {
+			return "", 0, err
+		}
+		gas, err := chain.Client.EstimateGas(ctx, ethereum.CallMsg{From: from.From, To: &contract.address, Data: data})
+		return KindCall, gas, err
+	}
+
+	opts := *from
+	opts.Context = ctx
+	opts.Value = value
+	tx, err := contract.bound.Transact(&opts, method.Name, args...)
+	if err != nil {
+		return "", 0, err
+	}
+	chain.Commit()
+	receipt, err := bind.WaitMined(ctx, chain.Client, tx)
+	if err != nil {
+		return "", 0, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return "", 0, fmt.Errorf("交易 %s 执行失败", tx.Hash().Hex())
+	}
+	return KindTx, receipt.GasUsed, nil
+}
+
+func loadABI(path string) (*abi.ABI, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	parsed, err := abi.JSON(file)
+	if err != nil {
+		return nil, fmt.Errorf("解析 ABI %s 失败: %w", path, err)
+	}
+	return &parsed, nil
+}
+
+// Markdown 输出 gas 表格
+func (r *Report) Markdown() string {
+	var b strings.Builder
+	b.WriteString("| Contract | Method | Kind | Calls | Min | Max | Avg |\n")
+	b.WriteString("|---|---|---|---:|---:|---:|---:|\n")
+	for _, e := range r.Entries {
+		fmt.Fprintf(&b, "| %s | %s | %s | %d | %d | %d | %d |\n", e.Contract, e.Method, e.Kind, e.Calls, e.Min, e.Max, e.Avg)
+	}
+	return b.String()
+}
+
+// Save 把报告保存为 JSON，作为以后对比的基线
+func (r *Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// LoadReport 读取 Save 保存的基线
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("解析基线 %s 失败: %w", path, err)
+	}
+	return &report, nil
+}
+
+// Regression 平均 gas 上涨超过阈值的方法
+type Regression struct {
+	Contract string `json:"contract"`
+	Method   string `json:"method"`
+	Baseline uint64 `json:"baseline"`
+	Current  uint64 `json:"current"`
+	// 上涨的百分比
+	Increase float64 `json:"increase"`
+}
+
+// Compare 对比 current 和 baseline 的平均 gas，返回上涨超过 threshold(百分比)的方法
+// 基线中没有的方法不参与对比
+func Compare(current, baseline *Report, threshold float64) []Regression {
+	base := make(map[string]*Entry, len(baseline.Entries))
+	for _, e := range baseline.Entries {
+		base[e.key()] = e
+	}
+	var regressions []Regression
+	for _, e := range current.Entries {
+		old, ok := base[e.key()]
+		if !ok || old.Avg == 0 || e.Avg <= old.Avg {
+			continue
+		}
+		increase := float64(e.Avg-old.Avg) / float64(old.Avg) * 100
+		if increase > threshold {
+			regressions = append(regressions, Regression{
+				Contract: e.Contract,
+				Method:   e.Method,
+				Baseline: old.Avg,
+				Current:  e.Avg,
+				Increase: increase,
+			})
+		}
+	}
+	sort.Slice(regressions, func(i, j int) bool {
+		return regressions[i].Increase > regressions[j].Increase
+	})
+	return regressions
+}
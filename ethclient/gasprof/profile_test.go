@@ -0,0 +1,69 @@
+package gasprof
+
+import (
+	"context"
+	"testing"
+
+	"ethclient/devchain"
+)
+
+// run 在新的内存链上执行场景文件
+func run(t *testing.T, path string) *Report {
+	t.Helper()
+	scenario, err := LoadScenario(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, err := devchain.New(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	report, err := Run(context.Background(), chain, scenario)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return report
+}
+
+// checkBaseline 内存链上的 gas 是确定的，不允许相对基线有任何上涨
+func checkBaseline(t *testing.T, report *Report, path string) {
+	t.Helper()
+	baseline, err := LoadReport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regressions := Compare(report, baseline, 0); len(regressions) > 0 {
+		t.Fatalf("gas 相对基线上涨: %+v", regressions)
+	}
+	if len(report.Entries) != len(baseline.Entries) {
+		t.Fatalf("统计了 %d 个方法，基线中有 %d 个", len(report.Entries), len(baseline.Entries))
+	}
+}
+
+func TestScenarioBaseline(t *testing.T) {
+	checkBaseline(t, run(t, "scenario.json"), "baseline.json")
+}
+
+// TestTasksScenario solidity_task 合约的场景，每个调用都能执行且 gas 不超过 tasks_baseline.json
+func TestTasksScenario(t *testing.T) {
+	report := run(t, "tasks.json")
+	checkBaseline(t, report, "tasks_baseline.json")
+	want := map[string]Kind{
+		"Voting.vote":              KindTx,
+		"Voting.getVotes":          KindCall,
+		"BeggingContract.donate":   KindTx,
+		"BeggingContract.withdraw": KindTx,
+		"Erc201.transfer":          KindTx,
+		"Erc201.mint":              KindTx,
+		"Erc201.totalSupply":       KindCall,
+	}
+	for _, e := range report.Entries {
+		if kind, ok := want[e.key()]; ok && kind == e.Kind && e.Avg > 0 {
+			delete(want, e.key())
+		}
+	}
+	if len(want) > 0 {
+		t.Fatalf("没有统计到: %v", want)
+	}
+}
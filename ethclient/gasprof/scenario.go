@@ -0,0 +1,67 @@
+package gasprof
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Scenario 场景文件：先部署 Contracts，再按顺序执行 Calls
+//
+//	{
+//	  "contracts": [
+//	    {"name": "Store", "abi": "Store_sol_Store.abi", "bin": "Store_sol_Store.bin", "args": ["1.0"]}
+//	  ],
+//	  "calls": [
+//	    {"contract": "Store", "method": "setItem", "args": ["0x01", "0x02"], "repeat": 10}
+//	  ]
+//	}
+type Scenario struct {
+	Contracts []Contract `json:"contracts"`
+	Calls     []Call     `json:"calls"`
+}
+
+// Contract 要部署的合约，abi/bin 是相对场景文件所在目录的路径
+type Contract struct {
+	Name string            `json:"name"`
+	ABI  string            `json:"abi"`
+	Bin  string            `json:"bin"`
+	Args []json.RawMessage `json:"args"`
+}
+
+// Call 一次方法调用，参数格式见 abiargs
+type Call struct {
+	Contract string            `json:"contract"`
+	Method   string            `json:"method"`
+	Args     []json.RawMessage `json:"args"`
+	// 发送交易的账户序号，对应 devchain.Chain.Accounts
+	From int `json:"from"`
+	// 随交易发送的 ETH，如 "1ether"、"20gwei"，没有单位时为 wei
+	Value string `json:"value"`
+	// 重复执行的次数，默认 1
+	Repeat int `json:"repeat"`
+}
+
+// LoadScenario 读取场景文件，并把 abi/bin 路径转换为相对当前目录的路径
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var scenario Scenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("解析场景文件 %s 失败: %w", path, err)
+	}
+	dir := filepath.Dir(path)
+	for i := range scenario.Contracts {
+		contract := &scenario.Contracts[i]
+		if !filepath.IsAbs(contract.ABI) {
+			contract.ABI = filepath.Join(dir, contract.ABI)
+		}
+		if !filepath.IsAbs(contract.Bin) {
+			contract.Bin = filepath.Join(dir, contract.Bin)
+		}
+	}
+	return &scenario, nil
+}
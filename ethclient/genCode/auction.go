@@ -0,0 +1,1551 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package genCode
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AuctionMetaData contains all meta data concerning the Auction contract.
+var AuctionMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_erc20Token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_nftOwner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_startingPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_priceOracle\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"messageId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"winner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"destinationChain\",\"type\":\"uint64\"}],\"name\":\"CrossChainAuctionEnded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"messageId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"sourceChain\",\"type\":\"uint64\"}],\"name\":\"CrossChainBidReceived\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ERC20_TOKEN\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ETH\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"bidIncrement\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ccipAdapter\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"crossChainBidIds\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"crossChainBids\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sourceChain\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"isWinner\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"endAuction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"expirationTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAuctionStatus\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"_startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expirationTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_startingPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_highestUSD\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_highestBidder\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"messageId\",\"type\":\"bytes32\"}],\"name\":\"getCrossChainBid\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sourceChain\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"isWinner\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCrossChainBidIds\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCrossChainWinnerInfo\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBidAmountERC20\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBidAmountETH\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTokenRates\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"ethRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"erc20Rate\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"highestBidder\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"highestPaymentToken\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"highestTokenAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"highestUSD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isWinnerCrossChain\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nftContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nftOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"name\":\"onERC721Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"placeBidERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"placeBidETH\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"priceOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"messageId\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"usdAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sourceChain\",\"type\":\"uint64\"}],\"name\":\"receiveCrossChainBid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ccipAdapter\",\"type\":\"address\"}],\"name\":\"setCcipAdapter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"startTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"startingPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"winner\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"destinationChain\",\"type\":\"uint64\"}],\"name\":\"transferNFTToCrossChainWinner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"winningCrossChainBidId\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// AuctionABI is the input ABI used to generate the binding from.
+// Deprecated: Use AuctionMetaData.ABI instead.
+var AuctionABI = AuctionMetaData.ABI
+
+// Auction is an auto generated Go binding around an Ethereum contract.
+type Auction struct {
+	AuctionCaller     // Read-only binding to the contract
+	AuctionTransactor // Write-only binding to the contract
+	AuctionFilterer   // Log filterer for contract events
+}
+
+// AuctionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AuctionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AuctionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AuctionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AuctionSession struct {
+	Contract     *Auction          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AuctionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AuctionCallerSession struct {
+	Contract *AuctionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// AuctionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AuctionTransactorSession struct {
+	Contract     *AuctionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// AuctionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AuctionRaw struct {
+	Contract *Auction // Generic contract binding to access the raw methods on
+}
+
+// AuctionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AuctionCallerRaw struct {
+	Contract *AuctionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AuctionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AuctionTransactorRaw struct {
+	Contract *AuctionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAuction creates a new instance of Auction, bound to a specific deployed contract.
+func NewAuction(address common.Address, backend bind.ContractBackend) (*Auction, error) {
+	contract, err := bindAuction(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Auction{AuctionCaller: AuctionCaller{contract: contract}, AuctionTransactor: AuctionTransactor{contract: contract}, AuctionFilterer: AuctionFilterer{contract: contract}}, nil
+}
+
+// NewAuctionCaller creates a new read-only instance of Auction, bound to a specific deployed contract.
+func NewAuctionCaller(address common.Address, caller bind.ContractCaller) (*AuctionCaller, error) {
+	contract, err := bindAuction(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionCaller{contract: contract}, nil
+}
+
+// NewAuctionTransactor creates a new write-only instance of Auction, bound to a specific deployed contract.
+func NewAuctionTransactor(address common.Address, transactor bind.ContractTransactor) (*AuctionTransactor, error) {
+	contract, err := bindAuction(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionTransactor{contract: contract}, nil
+}
+
+// NewAuctionFilterer creates a new log filterer instance of Auction, bound to a specific deployed contract.
+func NewAuctionFilterer(address common.Address, filterer bind.ContractFilterer) (*AuctionFilterer, error) {
+	contract, err := bindAuction(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFilterer{contract: contract}, nil
+}
+
+// bindAuction binds a generic wrapper to an already deployed contract.
+func bindAuction(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AuctionMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Auction *AuctionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Auction.Contract.AuctionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Auction *AuctionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Auction.Contract.AuctionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Auction *AuctionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Auction.Contract.AuctionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Auction *AuctionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Auction.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Auction *AuctionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Auction.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Auction *AuctionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Auction.Contract.contract.Transact(opts, method, params...)
+}
+
+// ERC20TOKEN is a free data retrieval call binding the contract method 0xefc4c631.
+//
+// Solidity: function ERC20_TOKEN() view returns(address)
+func (_Auction *AuctionCaller) ERC20TOKEN(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "ERC20_TOKEN")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ERC20TOKEN is a free data retrieval call binding the contract method 0xefc4c631.
+//
+// Solidity: function ERC20_TOKEN() view returns(address)
+func (_Auction *AuctionSession) ERC20TOKEN() (common.Address, error) {
+	return _Auction.Contract.ERC20TOKEN(&_Auction.CallOpts)
+}
+
+// ERC20TOKEN is a free data retrieval call binding the contract method 0xefc4c631.
+//
+// Solidity: function ERC20_TOKEN() view returns(address)
+func (_Auction *AuctionCallerSession) ERC20TOKEN() (common.Address, error) {
+	return _Auction.Contract.ERC20TOKEN(&_Auction.CallOpts)
+}
+
+// ETH is a free data retrieval call binding the contract method 0x8322fff2.
+//
+// Solidity: function ETH() view returns(address)
+func (_Auction *AuctionCaller) ETH(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "ETH")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ETH is a free data retrieval call binding the contract method 0x8322fff2.
+//
+// Solidity: function ETH() view returns(address)
+func (_Auction *AuctionSession) ETH() (common.Address, error) {
+	return _Auction.Contract.ETH(&_Auction.CallOpts)
+}
+
+// ETH is a free data retrieval call binding the contract method 0x8322fff2.
+//
+// Solidity: function ETH() view returns(address)
+func (_Auction *AuctionCallerSession) ETH() (common.Address, error) {
+	return _Auction.Contract.ETH(&_Auction.CallOpts)
+}
+
+// BidIncrement is a free data retrieval call binding the contract method 0xb3cc167a.
+//
+// Solidity: function bidIncrement() view returns(uint256)
+func (_Auction *AuctionCaller) BidIncrement(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "bidIncrement")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BidIncrement is a free data retrieval call binding the contract method 0xb3cc167a.
+//
+// Solidity: function bidIncrement() view returns(uint256)
+func (_Auction *AuctionSession) BidIncrement() (*big.Int, error) {
+	return _Auction.Contract.BidIncrement(&_Auction.CallOpts)
+}
+
+// BidIncrement is a free data retrieval call binding the contract method 0xb3cc167a.
+//
+// Solidity: function bidIncrement() view returns(uint256)
+func (_Auction *AuctionCallerSession) BidIncrement() (*big.Int, error) {
+	return _Auction.Contract.BidIncrement(&_Auction.CallOpts)
+}
+
+// CcipAdapter is a free data retrieval call binding the contract method 0x2aa0f85b.
+//
+// Solidity: function ccipAdapter() view returns(address)
+func (_Auction *AuctionCaller) CcipAdapter(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "ccipAdapter")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CcipAdapter is a free data retrieval call binding the contract method 0x2aa0f85b.
+//
+// Solidity: function ccipAdapter() view returns(address)
+func (_Auction *AuctionSession) CcipAdapter() (common.Address, error) {
+	return _Auction.Contract.CcipAdapter(&_Auction.CallOpts)
+}
+
+// CcipAdapter is a free data retrieval call binding the contract method 0x2aa0f85b.
+//
+// Solidity: function ccipAdapter() view returns(address)
+func (_Auction *AuctionCallerSession) CcipAdapter() (common.Address, error) {
+	return _Auction.Contract.CcipAdapter(&_Auction.CallOpts)
+}
+
+// CrossChainBidIds is a free data retrieval call binding the contract method 0xab49f60c.
+//
+// Solidity: function crossChainBidIds(uint256 ) view returns(bytes32)
+func (_Auction *AuctionCaller) CrossChainBidIds(opts *bind.CallOpts, arg0 *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "crossChainBidIds", arg0)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// CrossChainBidIds is a free data retrieval call binding the contract method 0xab49f60c.
+//
+// Solidity: function crossChainBidIds(uint256 ) view returns(bytes32)
+func (_Auction *AuctionSession) CrossChainBidIds(arg0 *big.Int) ([32]byte, error) {
+	return _Auction.Contract.CrossChainBidIds(&_Auction.CallOpts, arg0)
+}
+
+// CrossChainBidIds is a free data retrieval call binding the contract method 0xab49f60c.
+//
+// Solidity: function crossChainBidIds(uint256 ) view returns(bytes32)
+func (_Auction *AuctionCallerSession) CrossChainBidIds(arg0 *big.Int) ([32]byte, error) {
+	return _Auction.Contract.CrossChainBidIds(&_Auction.CallOpts, arg0)
+}
+
+// CrossChainBids is a free data retrieval call binding the contract method 0xdd4efa02.
+//
+// Solidity: function crossChainBids(bytes32 ) view returns(address bidder, uint256 amount, uint64 sourceChain, bool isWinner)
+func (_Auction *AuctionCaller) CrossChainBids(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	IsWinner    bool
+}, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "crossChainBids", arg0)
+
+	outstruct := new(struct {
+		Bidder      common.Address
+		Amount      *big.Int
+		SourceChain uint64
+		IsWinner    bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Bidder = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.SourceChain = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+	outstruct.IsWinner = *abi.ConvertType(out[3], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// CrossChainBids is a free data retrieval call binding the contract method 0xdd4efa02.
+//
+// Solidity: function crossChainBids(bytes32 ) view returns(address bidder, uint256 amount, uint64 sourceChain, bool isWinner)
+func (_Auction *AuctionSession) CrossChainBids(arg0 [32]byte) (struct {
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	IsWinner    bool
+}, error) {
+	return _Auction.Contract.CrossChainBids(&_Auction.CallOpts, arg0)
+}
+
+// CrossChainBids is a free data retrieval call binding the contract method 0xdd4efa02.
+//
+// Solidity: function crossChainBids(bytes32 ) view returns(address bidder, uint256 amount, uint64 sourceChain, bool isWinner)
+func (_Auction *AuctionCallerSession) CrossChainBids(arg0 [32]byte) (struct {
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	IsWinner    bool
+}, error) {
+	return _Auction.Contract.CrossChainBids(&_Auction.CallOpts, arg0)
+}
+
+// ExpirationTime is a free data retrieval call binding the contract method 0xda284dcc.
+//
+// Solidity: function expirationTime() view returns(uint256)
+func (_Auction *AuctionCaller) ExpirationTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "expirationTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExpirationTime is a free data retrieval call binding the contract method 0xda284dcc.
+//
+// Solidity: function expirationTime() view returns(uint256)
+func (_Auction *AuctionSession) ExpirationTime() (*big.Int, error) {
+	return _Auction.Contract.ExpirationTime(&_Auction.CallOpts)
+}
+
+// ExpirationTime is a free data retrieval call binding the contract method 0xda284dcc.
+//
+// Solidity: function expirationTime() view returns(uint256)
+func (_Auction *AuctionCallerSession) ExpirationTime() (*big.Int, error) {
+	return _Auction.Contract.ExpirationTime(&_Auction.CallOpts)
+}
+
+// GetAuctionStatus is a free data retrieval call binding the contract method 0x2e93be30.
+//
+// Solidity: function getAuctionStatus() view returns(uint256 _startTime, uint256 _expirationTime, uint256 _startingPrice, uint256 _bidIncrement, uint256 _highestUSD, address _highestBidder)
+func (_Auction *AuctionCaller) GetAuctionStatus(opts *bind.CallOpts) (struct {
+	StartTime      *big.Int
+	ExpirationTime *big.Int
+	StartingPrice  *big.Int
+	BidIncrement   *big.Int
+	HighestUSD     *big.Int
+	HighestBidder  common.Address
+}, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getAuctionStatus")
+
+	outstruct := new(struct {
+		StartTime      *big.Int
+		ExpirationTime *big.Int
+		StartingPrice  *big.Int
+		BidIncrement   *big.Int
+		HighestUSD     *big.Int
+		HighestBidder  common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StartTime = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.ExpirationTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartingPrice = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.BidIncrement = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.HighestUSD = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.HighestBidder = *abi.ConvertType(out[5], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// GetAuctionStatus is a free data retrieval call binding the contract method 0x2e93be30.
+//
+// Solidity: function getAuctionStatus() view returns(uint256 _startTime, uint256 _expirationTime, uint256 _startingPrice, uint256 _bidIncrement, uint256 _highestUSD, address _highestBidder)
+func (_Auction *AuctionSession) GetAuctionStatus() (struct {
+	StartTime      *big.Int
+	ExpirationTime *big.Int
+	StartingPrice  *big.Int
+	BidIncrement   *big.Int
+	HighestUSD     *big.Int
+	HighestBidder  common.Address
+}, error) {
+	return _Auction.Contract.GetAuctionStatus(&_Auction.CallOpts)
+}
+
+// GetAuctionStatus is a free data retrieval call binding the contract method 0x2e93be30.
+//
+// Solidity: function getAuctionStatus() view returns(uint256 _startTime, uint256 _expirationTime, uint256 _startingPrice, uint256 _bidIncrement, uint256 _highestUSD, address _highestBidder)
+func (_Auction *AuctionCallerSession) GetAuctionStatus() (struct {
+	StartTime      *big.Int
+	ExpirationTime *big.Int
+	StartingPrice  *big.Int
+	BidIncrement   *big.Int
+	HighestUSD     *big.Int
+	HighestBidder  common.Address
+}, error) {
+	return _Auction.Contract.GetAuctionStatus(&_Auction.CallOpts)
+}
+
+// GetCrossChainBid is a free data retrieval call binding the contract method 0x93298b02.
+//
+// Solidity: function getCrossChainBid(bytes32 messageId) view returns(address bidder, uint256 amount, uint64 sourceChain, bool isWinner)
+func (_Auction *AuctionCaller) GetCrossChainBid(opts *bind.CallOpts, messageId [32]byte) (struct {
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	IsWinner    bool
+}, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getCrossChainBid", messageId)
+
+	outstruct := new(struct {
+		Bidder      common.Address
+		Amount      *big.Int
+		SourceChain uint64
+		IsWinner    bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Bidder = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.SourceChain = *abi.ConvertType(out[2], new(uint64)).(*uint64)
+	outstruct.IsWinner = *abi.ConvertType(out[3], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetCrossChainBid is a free data retrieval call binding the contract method 0x93298b02.
+//
+// Solidity: function getCrossChainBid(bytes32 messageId) view returns(address bidder, uint256 amount, uint64 sourceChain, bool isWinner)
+func (_Auction *AuctionSession) GetCrossChainBid(messageId [32]byte) (struct {
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	IsWinner    bool
+}, error) {
+	return _Auction.Contract.GetCrossChainBid(&_Auction.CallOpts, messageId)
+}
+
+// GetCrossChainBid is a free data retrieval call binding the contract method 0x93298b02.
+//
+// Solidity: function getCrossChainBid(bytes32 messageId) view returns(address bidder, uint256 amount, uint64 sourceChain, bool isWinner)
+func (_Auction *AuctionCallerSession) GetCrossChainBid(messageId [32]byte) (struct {
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	IsWinner    bool
+}, error) {
+	return _Auction.Contract.GetCrossChainBid(&_Auction.CallOpts, messageId)
+}
+
+// GetCrossChainBidIds is a free data retrieval call binding the contract method 0xecba7d30.
+//
+// Solidity: function getCrossChainBidIds() view returns(bytes32[])
+func (_Auction *AuctionCaller) GetCrossChainBidIds(opts *bind.CallOpts) ([][32]byte, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getCrossChainBidIds")
+
+	if err != nil {
+		return *new([][32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][32]byte)).(*[][32]byte)
+
+	return out0, err
+
+}
+
+// GetCrossChainBidIds is a free data retrieval call binding the contract method 0xecba7d30.
+//
+// Solidity: function getCrossChainBidIds() view returns(bytes32[])
+func (_Auction *AuctionSession) GetCrossChainBidIds() ([][32]byte, error) {
+	return _Auction.Contract.GetCrossChainBidIds(&_Auction.CallOpts)
+}
+
+// GetCrossChainBidIds is a free data retrieval call binding the contract method 0xecba7d30.
+//
+// Solidity: function getCrossChainBidIds() view returns(bytes32[])
+func (_Auction *AuctionCallerSession) GetCrossChainBidIds() ([][32]byte, error) {
+	return _Auction.Contract.GetCrossChainBidIds(&_Auction.CallOpts)
+}
+
+// GetCrossChainWinnerInfo is a free data retrieval call binding the contract method 0xa3878fc0.
+//
+// Solidity: function getCrossChainWinnerInfo() view returns(bool, bytes32)
+func (_Auction *AuctionCaller) GetCrossChainWinnerInfo(opts *bind.CallOpts) (bool, [32]byte, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getCrossChainWinnerInfo")
+
+	if err != nil {
+		return *new(bool), *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	out1 := *abi.ConvertType(out[1], new([32]byte)).(*[32]byte)
+
+	return out0, out1, err
+
+}
+
+// GetCrossChainWinnerInfo is a free data retrieval call binding the contract method 0xa3878fc0.
+//
+// Solidity: function getCrossChainWinnerInfo() view returns(bool, bytes32)
+func (_Auction *AuctionSession) GetCrossChainWinnerInfo() (bool, [32]byte, error) {
+	return _Auction.Contract.GetCrossChainWinnerInfo(&_Auction.CallOpts)
+}
+
+// GetCrossChainWinnerInfo is a free data retrieval call binding the contract method 0xa3878fc0.
+//
+// Solidity: function getCrossChainWinnerInfo() view returns(bool, bytes32)
+func (_Auction *AuctionCallerSession) GetCrossChainWinnerInfo() (bool, [32]byte, error) {
+	return _Auction.Contract.GetCrossChainWinnerInfo(&_Auction.CallOpts)
+}
+
+// GetMinimumBidAmountERC20 is a free data retrieval call binding the contract method 0x2f3e622a.
+//
+// Solidity: function getMinimumBidAmountERC20() view returns(uint256)
+func (_Auction *AuctionCaller) GetMinimumBidAmountERC20(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getMinimumBidAmountERC20")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinimumBidAmountERC20 is a free data retrieval call binding the contract method 0x2f3e622a.
+//
+// Solidity: function getMinimumBidAmountERC20() view returns(uint256)
+func (_Auction *AuctionSession) GetMinimumBidAmountERC20() (*big.Int, error) {
+	return _Auction.Contract.GetMinimumBidAmountERC20(&_Auction.CallOpts)
+}
+
+// GetMinimumBidAmountERC20 is a free data retrieval call binding the contract method 0x2f3e622a.
+//
+// Solidity: function getMinimumBidAmountERC20() view returns(uint256)
+func (_Auction *AuctionCallerSession) GetMinimumBidAmountERC20() (*big.Int, error) {
+	return _Auction.Contract.GetMinimumBidAmountERC20(&_Auction.CallOpts)
+}
+
+// GetMinimumBidAmountETH is a free data retrieval call binding the contract method 0xdd439242.
+//
+// Solidity: function getMinimumBidAmountETH() view returns(uint256)
+func (_Auction *AuctionCaller) GetMinimumBidAmountETH(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getMinimumBidAmountETH")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinimumBidAmountETH is a free data retrieval call binding the contract method 0xdd439242.
+//
+// Solidity: function getMinimumBidAmountETH() view returns(uint256)
+func (_Auction *AuctionSession) GetMinimumBidAmountETH() (*big.Int, error) {
+	return _Auction.Contract.GetMinimumBidAmountETH(&_Auction.CallOpts)
+}
+
+// GetMinimumBidAmountETH is a free data retrieval call binding the contract method 0xdd439242.
+//
+// Solidity: function getMinimumBidAmountETH() view returns(uint256)
+func (_Auction *AuctionCallerSession) GetMinimumBidAmountETH() (*big.Int, error) {
+	return _Auction.Contract.GetMinimumBidAmountETH(&_Auction.CallOpts)
+}
+
+// GetTokenRates is a free data retrieval call binding the contract method 0x702ec091.
+//
+// Solidity: function getTokenRates() view returns(uint256 ethRate, uint256 erc20Rate)
+func (_Auction *AuctionCaller) GetTokenRates(opts *bind.CallOpts) (struct {
+	EthRate   *big.Int
+	Erc20Rate *big.Int
+}, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "getTokenRates")
+
+	outstruct := new(struct {
+		EthRate   *big.Int
+		Erc20Rate *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.EthRate = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Erc20Rate = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetTokenRates is a free data retrieval call binding the contract method 0x702ec091.
+//
+// Solidity: function getTokenRates() view returns(uint256 ethRate, uint256 erc20Rate)
+func (_Auction *AuctionSession) GetTokenRates() (struct {
+	EthRate   *big.Int
+	Erc20Rate *big.Int
+}, error) {
+	return _Auction.Contract.GetTokenRates(&_Auction.CallOpts)
+}
+
+// GetTokenRates is a free data retrieval call binding the contract method 0x702ec091.
+//
+// Solidity: function getTokenRates() view returns(uint256 ethRate, uint256 erc20Rate)
+func (_Auction *AuctionCallerSession) GetTokenRates() (struct {
+	EthRate   *big.Int
+	Erc20Rate *big.Int
+}, error) {
+	return _Auction.Contract.GetTokenRates(&_Auction.CallOpts)
+}
+
+// HighestBidder is a free data retrieval call binding the contract method 0x91f90157.
+//
+// Solidity: function highestBidder() view returns(address)
+func (_Auction *AuctionCaller) HighestBidder(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "highestBidder")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// HighestBidder is a free data retrieval call binding the contract method 0x91f90157.
+//
+// Solidity: function highestBidder() view returns(address)
+func (_Auction *AuctionSession) HighestBidder() (common.Address, error) {
+	return _Auction.Contract.HighestBidder(&_Auction.CallOpts)
+}
+
+// HighestBidder is a free data retrieval call binding the contract method 0x91f90157.
+//
+// Solidity: function highestBidder() view returns(address)
+func (_Auction *AuctionCallerSession) HighestBidder() (common.Address, error) {
+	return _Auction.Contract.HighestBidder(&_Auction.CallOpts)
+}
+
+// HighestPaymentToken is a free data retrieval call binding the contract method 0x0459c405.
+//
+// Solidity: function highestPaymentToken() view returns(address)
+func (_Auction *AuctionCaller) HighestPaymentToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "highestPaymentToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// HighestPaymentToken is a free data retrieval call binding the contract method 0x0459c405.
+//
+// Solidity: function highestPaymentToken() view returns(address)
+func (_Auction *AuctionSession) HighestPaymentToken() (common.Address, error) {
+	return _Auction.Contract.HighestPaymentToken(&_Auction.CallOpts)
+}
+
+// HighestPaymentToken is a free data retrieval call binding the contract method 0x0459c405.
+//
+// Solidity: function highestPaymentToken() view returns(address)
+func (_Auction *AuctionCallerSession) HighestPaymentToken() (common.Address, error) {
+	return _Auction.Contract.HighestPaymentToken(&_Auction.CallOpts)
+}
+
+// HighestTokenAmount is a free data retrieval call binding the contract method 0xe3ab4b95.
+//
+// Solidity: function highestTokenAmount() view returns(uint256)
+func (_Auction *AuctionCaller) HighestTokenAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "highestTokenAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// HighestTokenAmount is a free data retrieval call binding the contract method 0xe3ab4b95.
+//
+// Solidity: function highestTokenAmount() view returns(uint256)
+func (_Auction *AuctionSession) HighestTokenAmount() (*big.Int, error) {
+	return _Auction.Contract.HighestTokenAmount(&_Auction.CallOpts)
+}
+
+// HighestTokenAmount is a free data retrieval call binding the contract method 0xe3ab4b95.
+//
+// Solidity: function highestTokenAmount() view returns(uint256)
+func (_Auction *AuctionCallerSession) HighestTokenAmount() (*big.Int, error) {
+	return _Auction.Contract.HighestTokenAmount(&_Auction.CallOpts)
+}
+
+// HighestUSD is a free data retrieval call binding the contract method 0xb8fe4335.
+//
+// Solidity: function highestUSD() view returns(uint256)
+func (_Auction *AuctionCaller) HighestUSD(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "highestUSD")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// HighestUSD is a free data retrieval call binding the contract method 0xb8fe4335.
+//
+// Solidity: function highestUSD() view returns(uint256)
+func (_Auction *AuctionSession) HighestUSD() (*big.Int, error) {
+	return _Auction.Contract.HighestUSD(&_Auction.CallOpts)
+}
+
+// HighestUSD is a free data retrieval call binding the contract method 0xb8fe4335.
+//
+// Solidity: function highestUSD() view returns(uint256)
+func (_Auction *AuctionCallerSession) HighestUSD() (*big.Int, error) {
+	return _Auction.Contract.HighestUSD(&_Auction.CallOpts)
+}
+
+// IsWinnerCrossChain is a free data retrieval call binding the contract method 0x099b5ac1.
+//
+// Solidity: function isWinnerCrossChain() view returns(bool)
+func (_Auction *AuctionCaller) IsWinnerCrossChain(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "isWinnerCrossChain")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsWinnerCrossChain is a free data retrieval call binding the contract method 0x099b5ac1.
+//
+// Solidity: function isWinnerCrossChain() view returns(bool)
+func (_Auction *AuctionSession) IsWinnerCrossChain() (bool, error) {
+	return _Auction.Contract.IsWinnerCrossChain(&_Auction.CallOpts)
+}
+
+// IsWinnerCrossChain is a free data retrieval call binding the contract method 0x099b5ac1.
+//
+// Solidity: function isWinnerCrossChain() view returns(bool)
+func (_Auction *AuctionCallerSession) IsWinnerCrossChain() (bool, error) {
+	return _Auction.Contract.IsWinnerCrossChain(&_Auction.CallOpts)
+}
+
+// NftContract is a free data retrieval call binding the contract method 0xd56d229d.
+//
+// Solidity: function nftContract() view returns(address)
+func (_Auction *AuctionCaller) NftContract(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "nftContract")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NftContract is a free data retrieval call binding the contract method 0xd56d229d.
+//
+// Solidity: function nftContract() view returns(address)
+func (_Auction *AuctionSession) NftContract() (common.Address, error) {
+	return _Auction.Contract.NftContract(&_Auction.CallOpts)
+}
+
+// NftContract is a free data retrieval call binding the contract method 0xd56d229d.
+//
+// Solidity: function nftContract() view returns(address)
+func (_Auction *AuctionCallerSession) NftContract() (common.Address, error) {
+	return _Auction.Contract.NftContract(&_Auction.CallOpts)
+}
+
+// NftOwner is a free data retrieval call binding the contract method 0x4c39a749.
+//
+// Solidity: function nftOwner() view returns(address)
+func (_Auction *AuctionCaller) NftOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "nftOwner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NftOwner is a free data retrieval call binding the contract method 0x4c39a749.
+//
+// Solidity: function nftOwner() view returns(address)
+func (_Auction *AuctionSession) NftOwner() (common.Address, error) {
+	return _Auction.Contract.NftOwner(&_Auction.CallOpts)
+}
+
+// NftOwner is a free data retrieval call binding the contract method 0x4c39a749.
+//
+// Solidity: function nftOwner() view returns(address)
+func (_Auction *AuctionCallerSession) NftOwner() (common.Address, error) {
+	return _Auction.Contract.NftOwner(&_Auction.CallOpts)
+}
+
+// OnERC721Received is a free data retrieval call binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) pure returns(bytes4)
+func (_Auction *AuctionCaller) OnERC721Received(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) ([4]byte, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "onERC721Received", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// OnERC721Received is a free data retrieval call binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) pure returns(bytes4)
+func (_Auction *AuctionSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) ([4]byte, error) {
+	return _Auction.Contract.OnERC721Received(&_Auction.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// OnERC721Received is a free data retrieval call binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) pure returns(bytes4)
+func (_Auction *AuctionCallerSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) ([4]byte, error) {
+	return _Auction.Contract.OnERC721Received(&_Auction.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// PriceOracle is a free data retrieval call binding the contract method 0x2630c12f.
+//
+// Solidity: function priceOracle() view returns(address)
+func (_Auction *AuctionCaller) PriceOracle(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "priceOracle")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PriceOracle is a free data retrieval call binding the contract method 0x2630c12f.
+//
+// Solidity: function priceOracle() view returns(address)
+func (_Auction *AuctionSession) PriceOracle() (common.Address, error) {
+	return _Auction.Contract.PriceOracle(&_Auction.CallOpts)
+}
+
+// PriceOracle is a free data retrieval call binding the contract method 0x2630c12f.
+//
+// Solidity: function priceOracle() view returns(address)
+func (_Auction *AuctionCallerSession) PriceOracle() (common.Address, error) {
+	return _Auction.Contract.PriceOracle(&_Auction.CallOpts)
+}
+
+// StartTime is a free data retrieval call binding the contract method 0x78e97925.
+//
+// Solidity: function startTime() view returns(uint256)
+func (_Auction *AuctionCaller) StartTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "startTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StartTime is a free data retrieval call binding the contract method 0x78e97925.
+//
+// Solidity: function startTime() view returns(uint256)
+func (_Auction *AuctionSession) StartTime() (*big.Int, error) {
+	return _Auction.Contract.StartTime(&_Auction.CallOpts)
+}
+
+// StartTime is a free data retrieval call binding the contract method 0x78e97925.
+//
+// Solidity: function startTime() view returns(uint256)
+func (_Auction *AuctionCallerSession) StartTime() (*big.Int, error) {
+	return _Auction.Contract.StartTime(&_Auction.CallOpts)
+}
+
+// StartingPrice is a free data retrieval call binding the contract method 0xd6fbf202.
+//
+// Solidity: function startingPrice() view returns(uint256)
+func (_Auction *AuctionCaller) StartingPrice(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "startingPrice")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StartingPrice is a free data retrieval call binding the contract method 0xd6fbf202.
+//
+// Solidity: function startingPrice() view returns(uint256)
+func (_Auction *AuctionSession) StartingPrice() (*big.Int, error) {
+	return _Auction.Contract.StartingPrice(&_Auction.CallOpts)
+}
+
+// StartingPrice is a free data retrieval call binding the contract method 0xd6fbf202.
+//
+// Solidity: function startingPrice() view returns(uint256)
+func (_Auction *AuctionCallerSession) StartingPrice() (*big.Int, error) {
+	return _Auction.Contract.StartingPrice(&_Auction.CallOpts)
+}
+
+// TokenId is a free data retrieval call binding the contract method 0x17d70f7c.
+//
+// Solidity: function tokenId() view returns(uint256)
+func (_Auction *AuctionCaller) TokenId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "tokenId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenId is a free data retrieval call binding the contract method 0x17d70f7c.
+//
+// Solidity: function tokenId() view returns(uint256)
+func (_Auction *AuctionSession) TokenId() (*big.Int, error) {
+	return _Auction.Contract.TokenId(&_Auction.CallOpts)
+}
+
+// TokenId is a free data retrieval call binding the contract method 0x17d70f7c.
+//
+// Solidity: function tokenId() view returns(uint256)
+func (_Auction *AuctionCallerSession) TokenId() (*big.Int, error) {
+	return _Auction.Contract.TokenId(&_Auction.CallOpts)
+}
+
+// WinningCrossChainBidId is a free data retrieval call binding the contract method 0x3bf7f687.
+//
+// Solidity: function winningCrossChainBidId() view returns(bytes32)
+func (_Auction *AuctionCaller) WinningCrossChainBidId(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Auction.contract.Call(opts, &out, "winningCrossChainBidId")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// WinningCrossChainBidId is a free data retrieval call binding the contract method 0x3bf7f687.
+//
+// Solidity: function winningCrossChainBidId() view returns(bytes32)
+func (_Auction *AuctionSession) WinningCrossChainBidId() ([32]byte, error) {
+	return _Auction.Contract.WinningCrossChainBidId(&_Auction.CallOpts)
+}
+
+// WinningCrossChainBidId is a free data retrieval call binding the contract method 0x3bf7f687.
+//
+// Solidity: function winningCrossChainBidId() view returns(bytes32)
+func (_Auction *AuctionCallerSession) WinningCrossChainBidId() ([32]byte, error) {
+	return _Auction.Contract.WinningCrossChainBidId(&_Auction.CallOpts)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xfe67a54b.
+//
+// Solidity: function endAuction() returns()
+func (_Auction *AuctionTransactor) EndAuction(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "endAuction")
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xfe67a54b.
+//
+// Solidity: function endAuction() returns()
+func (_Auction *AuctionSession) EndAuction() (*types.Transaction, error) {
+	return _Auction.Contract.EndAuction(&_Auction.TransactOpts)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xfe67a54b.
+//
+// Solidity: function endAuction() returns()
+func (_Auction *AuctionTransactorSession) EndAuction() (*types.Transaction, error) {
+	return _Auction.Contract.EndAuction(&_Auction.TransactOpts)
+}
+
+// PlaceBidERC20 is a paid mutator transaction binding the contract method 0xd6b68a26.
+//
+// Solidity: function placeBidERC20(uint256 _amount) returns()
+func (_Auction *AuctionTransactor) PlaceBidERC20(opts *bind.TransactOpts, _amount *big.Int) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "placeBidERC20", _amount)
+}
+
+// PlaceBidERC20 is a paid mutator transaction binding the contract method 0xd6b68a26.
+//
+// Solidity: function placeBidERC20(uint256 _amount) returns()
+func (_Auction *AuctionSession) PlaceBidERC20(_amount *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.PlaceBidERC20(&_Auction.TransactOpts, _amount)
+}
+
+// PlaceBidERC20 is a paid mutator transaction binding the contract method 0xd6b68a26.
+//
+// Solidity: function placeBidERC20(uint256 _amount) returns()
+func (_Auction *AuctionTransactorSession) PlaceBidERC20(_amount *big.Int) (*types.Transaction, error) {
+	return _Auction.Contract.PlaceBidERC20(&_Auction.TransactOpts, _amount)
+}
+
+// PlaceBidETH is a paid mutator transaction binding the contract method 0xa7abfded.
+//
+// Solidity: function placeBidETH() payable returns()
+func (_Auction *AuctionTransactor) PlaceBidETH(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "placeBidETH")
+}
+
+// PlaceBidETH is a paid mutator transaction binding the contract method 0xa7abfded.
+//
+// Solidity: function placeBidETH() payable returns()
+func (_Auction *AuctionSession) PlaceBidETH() (*types.Transaction, error) {
+	return _Auction.Contract.PlaceBidETH(&_Auction.TransactOpts)
+}
+
+// PlaceBidETH is a paid mutator transaction binding the contract method 0xa7abfded.
+//
+// Solidity: function placeBidETH() payable returns()
+func (_Auction *AuctionTransactorSession) PlaceBidETH() (*types.Transaction, error) {
+	return _Auction.Contract.PlaceBidETH(&_Auction.TransactOpts)
+}
+
+// ReceiveCrossChainBid is a paid mutator transaction binding the contract method 0xf26d6c56.
+//
+// Solidity: function receiveCrossChainBid(bytes32 messageId, address bidder, uint256 usdAmount, uint64 sourceChain) returns()
+func (_Auction *AuctionTransactor) ReceiveCrossChainBid(opts *bind.TransactOpts, messageId [32]byte, bidder common.Address, usdAmount *big.Int, sourceChain uint64) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "receiveCrossChainBid", messageId, bidder, usdAmount, sourceChain)
+}
+
+// ReceiveCrossChainBid is a paid mutator transaction binding the contract method 0xf26d6c56.
+//
+// Solidity: function receiveCrossChainBid(bytes32 messageId, address bidder, uint256 usdAmount, uint64 sourceChain) returns()
+func (_Auction *AuctionSession) ReceiveCrossChainBid(messageId [32]byte, bidder common.Address, usdAmount *big.Int, sourceChain uint64) (*types.Transaction, error) {
+	return _Auction.Contract.ReceiveCrossChainBid(&_Auction.TransactOpts, messageId, bidder, usdAmount, sourceChain)
+}
+
+// ReceiveCrossChainBid is a paid mutator transaction binding the contract method 0xf26d6c56.
+//
+// Solidity: function receiveCrossChainBid(bytes32 messageId, address bidder, uint256 usdAmount, uint64 sourceChain) returns()
+func (_Auction *AuctionTransactorSession) ReceiveCrossChainBid(messageId [32]byte, bidder common.Address, usdAmount *big.Int, sourceChain uint64) (*types.Transaction, error) {
+	return _Auction.Contract.ReceiveCrossChainBid(&_Auction.TransactOpts, messageId, bidder, usdAmount, sourceChain)
+}
+
+// SetCcipAdapter is a paid mutator transaction binding the contract method 0xeab6b99e.
+//
+// Solidity: function setCcipAdapter(address _ccipAdapter) returns()
+func (_Auction *AuctionTransactor) SetCcipAdapter(opts *bind.TransactOpts, _ccipAdapter common.Address) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "setCcipAdapter", _ccipAdapter)
+}
+
+// SetCcipAdapter is a paid mutator transaction binding the contract method 0xeab6b99e.
+//
+// Solidity: function setCcipAdapter(address _ccipAdapter) returns()
+func (_Auction *AuctionSession) SetCcipAdapter(_ccipAdapter common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.SetCcipAdapter(&_Auction.TransactOpts, _ccipAdapter)
+}
+
+// SetCcipAdapter is a paid mutator transaction binding the contract method 0xeab6b99e.
+//
+// Solidity: function setCcipAdapter(address _ccipAdapter) returns()
+func (_Auction *AuctionTransactorSession) SetCcipAdapter(_ccipAdapter common.Address) (*types.Transaction, error) {
+	return _Auction.Contract.SetCcipAdapter(&_Auction.TransactOpts, _ccipAdapter)
+}
+
+// TransferNFTToCrossChainWinner is a paid mutator transaction binding the contract method 0xd50f40eb.
+//
+// Solidity: function transferNFTToCrossChainWinner(address winner, uint64 destinationChain) returns()
+func (_Auction *AuctionTransactor) TransferNFTToCrossChainWinner(opts *bind.TransactOpts, winner common.Address, destinationChain uint64) (*types.Transaction, error) {
+	return _Auction.contract.Transact(opts, "transferNFTToCrossChainWinner", winner, destinationChain)
+}
+
+// TransferNFTToCrossChainWinner is a paid mutator transaction binding the contract method 0xd50f40eb.
+//
+// Solidity: function transferNFTToCrossChainWinner(address winner, uint64 destinationChain) returns()
+func (_Auction *AuctionSession) TransferNFTToCrossChainWinner(winner common.Address, destinationChain uint64) (*types.Transaction, error) {
+	return _Auction.Contract.TransferNFTToCrossChainWinner(&_Auction.TransactOpts, winner, destinationChain)
+}
+
+// TransferNFTToCrossChainWinner is a paid mutator transaction binding the contract method 0xd50f40eb.
+//
+// Solidity: function transferNFTToCrossChainWinner(address winner, uint64 destinationChain) returns()
+func (_Auction *AuctionTransactorSession) TransferNFTToCrossChainWinner(winner common.Address, destinationChain uint64) (*types.Transaction, error) {
+	return _Auction.Contract.TransferNFTToCrossChainWinner(&_Auction.TransactOpts, winner, destinationChain)
+}
+
+// AuctionCrossChainAuctionEndedIterator is returned from FilterCrossChainAuctionEnded and is used to iterate over the raw logs and unpacked data for CrossChainAuctionEnded events raised by the Auction contract.
+type AuctionCrossChainAuctionEndedIterator struct {
+	Event *AuctionCrossChainAuctionEnded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionCrossChainAuctionEndedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionCrossChainAuctionEnded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionCrossChainAuctionEnded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionCrossChainAuctionEndedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionCrossChainAuctionEndedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionCrossChainAuctionEnded represents a CrossChainAuctionEnded event raised by the Auction contract.
+type AuctionCrossChainAuctionEnded struct {
+	MessageId        [32]byte
+	Winner           common.Address
+	Amount           *big.Int
+	DestinationChain uint64
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterCrossChainAuctionEnded is a free log retrieval operation binding the contract event 0xd89a36c3ead39f2aa33f35e6e849a5cddf9db89d929ece2791d4f535803d5017.
+//
+// Solidity: event CrossChainAuctionEnded(bytes32 indexed messageId, address indexed winner, uint256 amount, uint64 destinationChain)
+func (_Auction *AuctionFilterer) FilterCrossChainAuctionEnded(opts *bind.FilterOpts, messageId [][32]byte, winner []common.Address) (*AuctionCrossChainAuctionEndedIterator, error) {
+
+	var messageIdRule []interface{}
+	for _, messageIdItem := range messageId {
+		messageIdRule = append(messageIdRule, messageIdItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "CrossChainAuctionEnded", messageIdRule, winnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionCrossChainAuctionEndedIterator{contract: _Auction.contract, event: "CrossChainAuctionEnded", logs: logs, sub: sub}, nil
+}
+
+// WatchCrossChainAuctionEnded is a free log subscription operation binding the contract event 0xd89a36c3ead39f2aa33f35e6e849a5cddf9db89d929ece2791d4f535803d5017.
+//
+// Solidity: event CrossChainAuctionEnded(bytes32 indexed messageId, address indexed winner, uint256 amount, uint64 destinationChain)
+func (_Auction *AuctionFilterer) WatchCrossChainAuctionEnded(opts *bind.WatchOpts, sink chan<- *AuctionCrossChainAuctionEnded, messageId [][32]byte, winner []common.Address) (event.Subscription, error) {
+
+	var messageIdRule []interface{}
+	for _, messageIdItem := range messageId {
+		messageIdRule = append(messageIdRule, messageIdItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "CrossChainAuctionEnded", messageIdRule, winnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionCrossChainAuctionEnded)
+				if err := _Auction.contract.UnpackLog(event, "CrossChainAuctionEnded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCrossChainAuctionEnded is a log parse operation binding the contract event 0xd89a36c3ead39f2aa33f35e6e849a5cddf9db89d929ece2791d4f535803d5017.
+//
+// Solidity: event CrossChainAuctionEnded(bytes32 indexed messageId, address indexed winner, uint256 amount, uint64 destinationChain)
+func (_Auction *AuctionFilterer) ParseCrossChainAuctionEnded(log types.Log) (*AuctionCrossChainAuctionEnded, error) {
+	event := new(AuctionCrossChainAuctionEnded)
+	if err := _Auction.contract.UnpackLog(event, "CrossChainAuctionEnded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionCrossChainBidReceivedIterator is returned from FilterCrossChainBidReceived and is used to iterate over the raw logs and unpacked data for CrossChainBidReceived events raised by the Auction contract.
+type AuctionCrossChainBidReceivedIterator struct {
+	Event *AuctionCrossChainBidReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionCrossChainBidReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionCrossChainBidReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionCrossChainBidReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionCrossChainBidReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionCrossChainBidReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionCrossChainBidReceived represents a CrossChainBidReceived event raised by the Auction contract.
+type AuctionCrossChainBidReceived struct {
+	MessageId   [32]byte
+	Bidder      common.Address
+	Amount      *big.Int
+	SourceChain uint64
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterCrossChainBidReceived is a free log retrieval operation binding the contract event 0x2243d14508266c0d39815241005eba47488e2f587f71f6df0793d737886c0867.
+//
+// Solidity: event CrossChainBidReceived(bytes32 indexed messageId, address indexed bidder, uint256 amount, uint64 sourceChain)
+func (_Auction *AuctionFilterer) FilterCrossChainBidReceived(opts *bind.FilterOpts, messageId [][32]byte, bidder []common.Address) (*AuctionCrossChainBidReceivedIterator, error) {
+
+	var messageIdRule []interface{}
+	for _, messageIdItem := range messageId {
+		messageIdRule = append(messageIdRule, messageIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _Auction.contract.FilterLogs(opts, "CrossChainBidReceived", messageIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionCrossChainBidReceivedIterator{contract: _Auction.contract, event: "CrossChainBidReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchCrossChainBidReceived is a free log subscription operation binding the contract event 0x2243d14508266c0d39815241005eba47488e2f587f71f6df0793d737886c0867.
+//
+// Solidity: event CrossChainBidReceived(bytes32 indexed messageId, address indexed bidder, uint256 amount, uint64 sourceChain)
+func (_Auction *AuctionFilterer) WatchCrossChainBidReceived(opts *bind.WatchOpts, sink chan<- *AuctionCrossChainBidReceived, messageId [][32]byte, bidder []common.Address) (event.Subscription, error) {
+
+	var messageIdRule []interface{}
+	for _, messageIdItem := range messageId {
+		messageIdRule = append(messageIdRule, messageIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _Auction.contract.WatchLogs(opts, "CrossChainBidReceived", messageIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionCrossChainBidReceived)
+				if err := _Auction.contract.UnpackLog(event, "CrossChainBidReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCrossChainBidReceived is a log parse operation binding the contract event 0x2243d14508266c0d39815241005eba47488e2f587f71f6df0793d737886c0867.
+//
+// Solidity: event CrossChainBidReceived(bytes32 indexed messageId, address indexed bidder, uint256 amount, uint64 sourceChain)
+func (_Auction *AuctionFilterer) ParseCrossChainBidReceived(log types.Log) (*AuctionCrossChainBidReceived, error) {
+	event := new(AuctionCrossChainBidReceived)
+	if err := _Auction.contract.UnpackLog(event, "CrossChainBidReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
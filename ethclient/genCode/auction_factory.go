@@ -0,0 +1,903 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package genCode
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AuctionFactoryMetaData contains all meta data concerning the AuctionFactory contract.
+var AuctionFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"Auctions\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADE_INTERFACE_VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"erc20Token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startingPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bidIncrement\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"priceOracle\",\"type\":\"address\"}],\"name\":\"createAuction\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAuctions\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"name\":\"onERC721Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// AuctionFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use AuctionFactoryMetaData.ABI instead.
+var AuctionFactoryABI = AuctionFactoryMetaData.ABI
+
+// AuctionFactory is an auto generated Go binding around an Ethereum contract.
+type AuctionFactory struct {
+	AuctionFactoryCaller     // Read-only binding to the contract
+	AuctionFactoryTransactor // Write-only binding to the contract
+	AuctionFactoryFilterer   // Log filterer for contract events
+}
+
+// AuctionFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AuctionFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AuctionFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AuctionFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AuctionFactorySession struct {
+	Contract     *AuctionFactory   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AuctionFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AuctionFactoryCallerSession struct {
+	Contract *AuctionFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// AuctionFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AuctionFactoryTransactorSession struct {
+	Contract     *AuctionFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// AuctionFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AuctionFactoryRaw struct {
+	Contract *AuctionFactory // Generic contract binding to access the raw methods on
+}
+
+// AuctionFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AuctionFactoryCallerRaw struct {
+	Contract *AuctionFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AuctionFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AuctionFactoryTransactorRaw struct {
+	Contract *AuctionFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAuctionFactory creates a new instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactory(address common.Address, backend bind.ContractBackend) (*AuctionFactory, error) {
+	contract, err := bindAuctionFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactory{AuctionFactoryCaller: AuctionFactoryCaller{contract: contract}, AuctionFactoryTransactor: AuctionFactoryTransactor{contract: contract}, AuctionFactoryFilterer: AuctionFactoryFilterer{contract: contract}}, nil
+}
+
+// NewAuctionFactoryCaller creates a new read-only instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryCaller(address common.Address, caller bind.ContractCaller) (*AuctionFactoryCaller, error) {
+	contract, err := bindAuctionFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryCaller{contract: contract}, nil
+}
+
+// NewAuctionFactoryTransactor creates a new write-only instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*AuctionFactoryTransactor, error) {
+	contract, err := bindAuctionFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryTransactor{contract: contract}, nil
+}
+
+// NewAuctionFactoryFilterer creates a new log filterer instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*AuctionFactoryFilterer, error) {
+	contract, err := bindAuctionFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryFilterer{contract: contract}, nil
+}
+
+// bindAuctionFactory binds a generic wrapper to an already deployed contract.
+func bindAuctionFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AuctionFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuctionFactory *AuctionFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuctionFactory.Contract.AuctionFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuctionFactory *AuctionFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.AuctionFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuctionFactory *AuctionFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.AuctionFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuctionFactory *AuctionFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuctionFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuctionFactory *AuctionFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuctionFactory *AuctionFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// Auctions is a free data retrieval call binding the contract method 0xe8cd181f.
+//
+// Solidity: function Auctions(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) Auctions(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "Auctions", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Auctions is a free data retrieval call binding the contract method 0xe8cd181f.
+//
+// Solidity: function Auctions(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactorySession) Auctions(arg0 *big.Int) (common.Address, error) {
+	return _AuctionFactory.Contract.Auctions(&_AuctionFactory.CallOpts, arg0)
+}
+
+// Auctions is a free data retrieval call binding the contract method 0xe8cd181f.
+//
+// Solidity: function Auctions(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) Auctions(arg0 *big.Int) (common.Address, error) {
+	return _AuctionFactory.Contract.Auctions(&_AuctionFactory.CallOpts, arg0)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_AuctionFactory *AuctionFactoryCaller) UPGRADEINTERFACEVERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "UPGRADE_INTERFACE_VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_AuctionFactory *AuctionFactorySession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _AuctionFactory.Contract.UPGRADEINTERFACEVERSION(&_AuctionFactory.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_AuctionFactory *AuctionFactoryCallerSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _AuctionFactory.Contract.UPGRADEINTERFACEVERSION(&_AuctionFactory.CallOpts)
+}
+
+// GetAuctions is a free data retrieval call binding the contract method 0xd7c06919.
+//
+// Solidity: function getAuctions() view returns(address[])
+func (_AuctionFactory *AuctionFactoryCaller) GetAuctions(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getAuctions")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetAuctions is a free data retrieval call binding the contract method 0xd7c06919.
+//
+// Solidity: function getAuctions() view returns(address[])
+func (_AuctionFactory *AuctionFactorySession) GetAuctions() ([]common.Address, error) {
+	return _AuctionFactory.Contract.GetAuctions(&_AuctionFactory.CallOpts)
+}
+
+// GetAuctions is a free data retrieval call binding the contract method 0xd7c06919.
+//
+// Solidity: function getAuctions() view returns(address[])
+func (_AuctionFactory *AuctionFactoryCallerSession) GetAuctions() ([]common.Address, error) {
+	return _AuctionFactory.Contract.GetAuctions(&_AuctionFactory.CallOpts)
+}
+
+// OnERC721Received is a free data retrieval call binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) pure returns(bytes4)
+func (_AuctionFactory *AuctionFactoryCaller) OnERC721Received(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) ([4]byte, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "onERC721Received", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// OnERC721Received is a free data retrieval call binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) pure returns(bytes4)
+func (_AuctionFactory *AuctionFactorySession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) ([4]byte, error) {
+	return _AuctionFactory.Contract.OnERC721Received(&_AuctionFactory.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// OnERC721Received is a free data retrieval call binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) pure returns(bytes4)
+func (_AuctionFactory *AuctionFactoryCallerSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) ([4]byte, error) {
+	return _AuctionFactory.Contract.OnERC721Received(&_AuctionFactory.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) Owner() (common.Address, error) {
+	return _AuctionFactory.Contract.Owner(&_AuctionFactory.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) Owner() (common.Address, error) {
+	return _AuctionFactory.Contract.Owner(&_AuctionFactory.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AuctionFactory *AuctionFactoryCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AuctionFactory *AuctionFactorySession) ProxiableUUID() ([32]byte, error) {
+	return _AuctionFactory.Contract.ProxiableUUID(&_AuctionFactory.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_AuctionFactory *AuctionFactoryCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _AuctionFactory.Contract.ProxiableUUID(&_AuctionFactory.CallOpts)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0xffb07c71.
+//
+// Solidity: function createAuction(address erc20Token, address nftContract, uint256 tokenId, uint256 startingPrice, uint256 bidIncrement, uint256 duration, address priceOracle) returns(address)
+func (_AuctionFactory *AuctionFactoryTransactor) CreateAuction(opts *bind.TransactOpts, erc20Token common.Address, nftContract common.Address, tokenId *big.Int, startingPrice *big.Int, bidIncrement *big.Int, duration *big.Int, priceOracle common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "createAuction", erc20Token, nftContract, tokenId, startingPrice, bidIncrement, duration, priceOracle)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0xffb07c71.
+//
+// Solidity: function createAuction(address erc20Token, address nftContract, uint256 tokenId, uint256 startingPrice, uint256 bidIncrement, uint256 duration, address priceOracle) returns(address)
+func (_AuctionFactory *AuctionFactorySession) CreateAuction(erc20Token common.Address, nftContract common.Address, tokenId *big.Int, startingPrice *big.Int, bidIncrement *big.Int, duration *big.Int, priceOracle common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuction(&_AuctionFactory.TransactOpts, erc20Token, nftContract, tokenId, startingPrice, bidIncrement, duration, priceOracle)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0xffb07c71.
+//
+// Solidity: function createAuction(address erc20Token, address nftContract, uint256 tokenId, uint256 startingPrice, uint256 bidIncrement, uint256 duration, address priceOracle) returns(address)
+func (_AuctionFactory *AuctionFactoryTransactorSession) CreateAuction(erc20Token common.Address, nftContract common.Address, tokenId *big.Int, startingPrice *big.Int, bidIncrement *big.Int, duration *big.Int, priceOracle common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuction(&_AuctionFactory.TransactOpts, erc20Token, nftContract, tokenId, startingPrice, bidIncrement, duration, priceOracle)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
+//
+// Solidity: function initialize() returns()
+func (_AuctionFactory *AuctionFactoryTransactor) Initialize(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "initialize")
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
+//
+// Solidity: function initialize() returns()
+func (_AuctionFactory *AuctionFactorySession) Initialize() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.Initialize(&_AuctionFactory.TransactOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
+//
+// Solidity: function initialize() returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) Initialize() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.Initialize(&_AuctionFactory.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactoryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactorySession) RenounceOwnership() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.RenounceOwnership(&_AuctionFactory.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.RenounceOwnership(&_AuctionFactory.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactorySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.TransferOwnership(&_AuctionFactory.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.TransferOwnership(&_AuctionFactory.TransactOpts, newOwner)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AuctionFactory *AuctionFactoryTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AuctionFactory *AuctionFactorySession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.UpgradeToAndCall(&_AuctionFactory.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.UpgradeToAndCall(&_AuctionFactory.TransactOpts, newImplementation, data)
+}
+
+// AuctionFactoryInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the AuctionFactory contract.
+type AuctionFactoryInitializedIterator struct {
+	Event *AuctionFactoryInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryInitialized represents a Initialized event raised by the AuctionFactory contract.
+type AuctionFactoryInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterInitialized(opts *bind.FilterOpts) (*AuctionFactoryInitializedIterator, error) {
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryInitializedIterator{contract: _AuctionFactory.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *AuctionFactoryInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryInitialized)
+				if err := _AuctionFactory.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseInitialized(log types.Log) (*AuctionFactoryInitialized, error) {
+	event := new(AuctionFactoryInitialized)
+	if err := _AuctionFactory.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the AuctionFactory contract.
+type AuctionFactoryOwnershipTransferredIterator struct {
+	Event *AuctionFactoryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryOwnershipTransferred represents a OwnershipTransferred event raised by the AuctionFactory contract.
+type AuctionFactoryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AuctionFactoryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryOwnershipTransferredIterator{contract: _AuctionFactory.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AuctionFactoryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryOwnershipTransferred)
+				if err := _AuctionFactory.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseOwnershipTransferred(log types.Log) (*AuctionFactoryOwnershipTransferred, error) {
+	event := new(AuctionFactoryOwnershipTransferred)
+	if err := _AuctionFactory.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the AuctionFactory contract.
+type AuctionFactoryUpgradedIterator struct {
+	Event *AuctionFactoryUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryUpgraded represents a Upgraded event raised by the AuctionFactory contract.
+type AuctionFactoryUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*AuctionFactoryUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryUpgradedIterator{contract: _AuctionFactory.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *AuctionFactoryUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryUpgraded)
+				if err := _AuctionFactory.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseUpgraded(log types.Log) (*AuctionFactoryUpgraded, error) {
+	event := new(AuctionFactoryUpgraded)
+	if err := _AuctionFactory.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
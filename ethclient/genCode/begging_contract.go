@@ -0,0 +1,597 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package genCode
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BeggingContractMetaData contains all meta data concerning the BeggingContract contract.
+var BeggingContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"donor\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Donation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Withdrawal\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"donate\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"donations\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"getDonation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600e575f5ffd5b50335f5f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055506105d78061005b5f395ff3fe60806040526004361061003e575f3560e01c80633ccfd60b14610042578063410a1d3214610058578063cc6cb19a14610094578063ed88c68e146100d0575b5f5ffd5b34801561004d575f5ffd5b506100566100da565b005b348015610063575f5ffd5b5061007e600480360381019061007991906103df565b610283565b60405161008b9190610422565b60405180910390f35b34801561009f575f5ffd5b506100ba60048036038101906100b591906103df565b6102c9565b6040516100c79190610422565b60405180910390f35b6100d86102de565b005b5f5f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1614610168576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161015f906104bb565b60405180910390fd5b5f4790505f81116101ae576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016101a590610523565b60405180910390fd5b5f5f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff166108fc8290811502906040515f60405180830381858888f19350505050158015610211573d5f5f3e3d5ffd5b505f5f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65826040516102789190610422565b60405180910390a250565b5f60015f8373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20549050919050565b6001602052805f5260405f205f915090505481565b3460015f3373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f82825461032a919061056e565b925050819055503373ffffffffffffffffffffffffffffffffffffffff167f5d8bc849764969eb1bcc6d0a2f55999d0167c1ccec240a4f39cf664ca9c4148e346040516103779190610422565b60405180910390a2565b5f5ffd5b5f73ffffffffffffffffffffffffffffffffffffffff82169050919050565b5f6103ae82610385565b9050919050565b6103be816103a4565b81146103c8575f5ffd5b50565b5f813590506103d9816103b5565b92915050565b5f602082840312156103f4576103f3610381565b5b5f610401848285016103cb565b91505092915050565b5f819050919050565b61041c8161040a565b82525050565b5f6020820190506104355f830184610413565b92915050565b5f82825260208201905092915050565b7f4f6e6c7920746865206f776e65722063616e2063616c6c20746869732066756e5f8201527f6374696f6e2e0000000000000000000000000000000000000000000000000000602082015250565b5f6104a560268361043b565b91506104b08261044b565b604082019050919050565b5f6020820190508181035f8301526104d281610499565b9050919050565b7f4e6f2062616c616e636520746f2077697468647261772e0000000000000000005f82015250565b5f61050d60178361043b565b9150610518826104d9565b602082019050919050565b5f6020820190508181035f83015261053a81610501565b9050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f6105788261040a565b91506105838361040a565b925082820190508082111561059b5761059a610541565b5b9291505056fea26469706673582212203b723a301bb7061a380b1f1b550f6de5b7e29537d67294f1c58859475359557364736f6c634300081e0033",
+}
+
+// BeggingContractABI is the input ABI used to generate the binding from.
+// Deprecated: Use BeggingContractMetaData.ABI instead.
+var BeggingContractABI = BeggingContractMetaData.ABI
+
+// BeggingContractBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use BeggingContractMetaData.Bin instead.
+var BeggingContractBin = BeggingContractMetaData.Bin
+
+// DeployBeggingContract deploys a new Ethereum contract, binding an instance of BeggingContract to it.
+func DeployBeggingContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *BeggingContract, error) {
+	parsed, err := BeggingContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BeggingContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &BeggingContract{BeggingContractCaller: BeggingContractCaller{contract: contract}, BeggingContractTransactor: BeggingContractTransactor{contract: contract}, BeggingContractFilterer: BeggingContractFilterer{contract: contract}}, nil
+}
+
+// BeggingContract is an auto generated Go binding around an Ethereum contract.
+type BeggingContract struct {
+	BeggingContractCaller     // Read-only binding to the contract
+	BeggingContractTransactor // Write-only binding to the contract
+	BeggingContractFilterer   // Log filterer for contract events
+}
+
+// BeggingContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BeggingContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BeggingContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BeggingContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BeggingContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BeggingContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BeggingContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BeggingContractSession struct {
+	Contract     *BeggingContract  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BeggingContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BeggingContractCallerSession struct {
+	Contract *BeggingContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// BeggingContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BeggingContractTransactorSession struct {
+	Contract     *BeggingContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// BeggingContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BeggingContractRaw struct {
+	Contract *BeggingContract // Generic contract binding to access the raw methods on
+}
+
+// BeggingContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BeggingContractCallerRaw struct {
+	Contract *BeggingContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BeggingContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BeggingContractTransactorRaw struct {
+	Contract *BeggingContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBeggingContract creates a new instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContract(address common.Address, backend bind.ContractBackend) (*BeggingContract, error) {
+	contract, err := bindBeggingContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContract{BeggingContractCaller: BeggingContractCaller{contract: contract}, BeggingContractTransactor: BeggingContractTransactor{contract: contract}, BeggingContractFilterer: BeggingContractFilterer{contract: contract}}, nil
+}
+
+// NewBeggingContractCaller creates a new read-only instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContractCaller(address common.Address, caller bind.ContractCaller) (*BeggingContractCaller, error) {
+	contract, err := bindBeggingContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractCaller{contract: contract}, nil
+}
+
+// NewBeggingContractTransactor creates a new write-only instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContractTransactor(address common.Address, transactor bind.ContractTransactor) (*BeggingContractTransactor, error) {
+	contract, err := bindBeggingContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractTransactor{contract: contract}, nil
+}
+
+// NewBeggingContractFilterer creates a new log filterer instance of BeggingContract, bound to a specific deployed contract.
+func NewBeggingContractFilterer(address common.Address, filterer bind.ContractFilterer) (*BeggingContractFilterer, error) {
+	contract, err := bindBeggingContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractFilterer{contract: contract}, nil
+}
+
+// bindBeggingContract binds a generic wrapper to an already deployed contract.
+func bindBeggingContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BeggingContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BeggingContract *BeggingContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BeggingContract.Contract.BeggingContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BeggingContract *BeggingContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.Contract.BeggingContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BeggingContract *BeggingContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BeggingContract.Contract.BeggingContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BeggingContract *BeggingContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BeggingContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BeggingContract *BeggingContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BeggingContract *BeggingContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BeggingContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// Donations is a free data retrieval call binding the contract method 0xcc6cb19a.
+//
+// Solidity: function donations(address ) view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) Donations(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "donations", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Donations is a free data retrieval call binding the contract method 0xcc6cb19a.
+//
+// Solidity: function donations(address ) view returns(uint256)
+func (_BeggingContract *BeggingContractSession) Donations(arg0 common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.Donations(&_BeggingContract.CallOpts, arg0)
+}
+
+// Donations is a free data retrieval call binding the contract method 0xcc6cb19a.
+//
+// Solidity: function donations(address ) view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) Donations(arg0 common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.Donations(&_BeggingContract.CallOpts, arg0)
+}
+
+// GetDonation is a free data retrieval call binding the contract method 0x410a1d32.
+//
+// Solidity: function getDonation(address _address) view returns(uint256)
+func (_BeggingContract *BeggingContractCaller) GetDonation(opts *bind.CallOpts, _address common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BeggingContract.contract.Call(opts, &out, "getDonation", _address)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetDonation is a free data retrieval call binding the contract method 0x410a1d32.
+//
+// Solidity: function getDonation(address _address) view returns(uint256)
+func (_BeggingContract *BeggingContractSession) GetDonation(_address common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.GetDonation(&_BeggingContract.CallOpts, _address)
+}
+
+// GetDonation is a free data retrieval call binding the contract method 0x410a1d32.
+//
+// Solidity: function getDonation(address _address) view returns(uint256)
+func (_BeggingContract *BeggingContractCallerSession) GetDonation(_address common.Address) (*big.Int, error) {
+	return _BeggingContract.Contract.GetDonation(&_BeggingContract.CallOpts, _address)
+}
+
+// Donate is a paid mutator transaction binding the contract method 0xed88c68e.
+//
+// Solidity: function donate() payable returns()
+func (_BeggingContract *BeggingContractTransactor) Donate(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.contract.Transact(opts, "donate")
+}
+
+// Donate is a paid mutator transaction binding the contract method 0xed88c68e.
+//
+// Solidity: function donate() payable returns()
+func (_BeggingContract *BeggingContractSession) Donate() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Donate(&_BeggingContract.TransactOpts)
+}
+
+// Donate is a paid mutator transaction binding the contract method 0xed88c68e.
+//
+// Solidity: function donate() payable returns()
+func (_BeggingContract *BeggingContractTransactorSession) Donate() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Donate(&_BeggingContract.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_BeggingContract *BeggingContractTransactor) Withdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BeggingContract.contract.Transact(opts, "withdraw")
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_BeggingContract *BeggingContractSession) Withdraw() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Withdraw(&_BeggingContract.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_BeggingContract *BeggingContractTransactorSession) Withdraw() (*types.Transaction, error) {
+	return _BeggingContract.Contract.Withdraw(&_BeggingContract.TransactOpts)
+}
+
+// BeggingContractDonationIterator is returned from FilterDonation and is used to iterate over the raw logs and unpacked data for Donation events raised by the BeggingContract contract.
+type BeggingContractDonationIterator struct {
+	Event *BeggingContractDonation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BeggingContractDonationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BeggingContractDonation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BeggingContractDonation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BeggingContractDonationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BeggingContractDonationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BeggingContractDonation represents a Donation event raised by the BeggingContract contract.
+type BeggingContractDonation struct {
+	Donor  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDonation is a free log retrieval operation binding the contract event 0x5d8bc849764969eb1bcc6d0a2f55999d0167c1ccec240a4f39cf664ca9c4148e.
+//
+// Solidity: event Donation(address indexed donor, uint256 amount)
+func (_BeggingContract *BeggingContractFilterer) FilterDonation(opts *bind.FilterOpts, donor []common.Address) (*BeggingContractDonationIterator, error) {
+
+	var donorRule []interface{}
+	for _, donorItem := range donor {
+		donorRule = append(donorRule, donorItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.FilterLogs(opts, "Donation", donorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractDonationIterator{contract: _BeggingContract.contract, event: "Donation", logs: logs, sub: sub}, nil
+}
+
+// WatchDonation is a free log subscription operation binding the contract event 0x5d8bc849764969eb1bcc6d0a2f55999d0167c1ccec240a4f39cf664ca9c4148e.
+//
+// Solidity: event Donation(address indexed donor, uint256 amount)
+func (_BeggingContract *BeggingContractFilterer) WatchDonation(opts *bind.WatchOpts, sink chan<- *BeggingContractDonation, donor []common.Address) (event.Subscription, error) {
+
+	var donorRule []interface{}
+	for _, donorItem := range donor {
+		donorRule = append(donorRule, donorItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.WatchLogs(opts, "Donation", donorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BeggingContractDonation)
+				if err := _BeggingContract.contract.UnpackLog(event, "Donation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDonation is a log parse operation binding the contract event 0x5d8bc849764969eb1bcc6d0a2f55999d0167c1ccec240a4f39cf664ca9c4148e.
+//
+// Solidity: event Donation(address indexed donor, uint256 amount)
+func (_BeggingContract *BeggingContractFilterer) ParseDonation(log types.Log) (*BeggingContractDonation, error) {
+	event := new(BeggingContractDonation)
+	if err := _BeggingContract.contract.UnpackLog(event, "Donation", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BeggingContractWithdrawalIterator is returned from FilterWithdrawal and is used to iterate over the raw logs and unpacked data for Withdrawal events raised by the BeggingContract contract.
+type BeggingContractWithdrawalIterator struct {
+	Event *BeggingContractWithdrawal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BeggingContractWithdrawalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BeggingContractWithdrawal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BeggingContractWithdrawal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BeggingContractWithdrawalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BeggingContractWithdrawalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BeggingContractWithdrawal represents a Withdrawal event raised by the BeggingContract contract.
+type BeggingContractWithdrawal struct {
+	Owner  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawal is a free log retrieval operation binding the contract event 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65.
+//
+// Solidity: event Withdrawal(address indexed owner, uint256 amount)
+func (_BeggingContract *BeggingContractFilterer) FilterWithdrawal(opts *bind.FilterOpts, owner []common.Address) (*BeggingContractWithdrawalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.FilterLogs(opts, "Withdrawal", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BeggingContractWithdrawalIterator{contract: _BeggingContract.contract, event: "Withdrawal", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawal is a free log subscription operation binding the contract event 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65.
+//
+// Solidity: event Withdrawal(address indexed owner, uint256 amount)
+func (_BeggingContract *BeggingContractFilterer) WatchWithdrawal(opts *bind.WatchOpts, sink chan<- *BeggingContractWithdrawal, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _BeggingContract.contract.WatchLogs(opts, "Withdrawal", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BeggingContractWithdrawal)
+				if err := _BeggingContract.contract.UnpackLog(event, "Withdrawal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawal is a log parse operation binding the contract event 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65.
+//
+// Solidity: event Withdrawal(address indexed owner, uint256 amount)
+func (_BeggingContract *BeggingContractFilterer) ParseWithdrawal(log types.Log) (*BeggingContractWithdrawal, error) {
+	event := new(BeggingContractWithdrawal)
+	if err := _BeggingContract.contract.UnpackLog(event, "Withdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,750 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package genCode
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Erc201MetaData contains all meta data concerning the Erc201 contract.
+var Erc201MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561000f575f5ffd5b50604051611e2d380380611e2d8339818101604052810190610031919061043a565b815f908161003f91906106c0565b50806001908161004f91906106c0565b50601260025f6101000a81548160ff021916908360ff160217905550620f42406003819055503360055f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555061010d60055f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1660025f9054906101000a900460ff16600a6100f591906108f7565b6003546101029190610941565b61011560201b60201c565b505050610abd565b5f60055f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff16146101a5576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161019c906109dc565b60405180910390fd5b5f73ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610213576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161020a90610a44565b60405180910390fd5b8160035f8282546102249190610a62565b925050819055508160045f8573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f8282546102779190610a62565b925050819055508273ffffffffffffffffffffffffffffffffffffffff165f73ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516102db9190610aa4565b60405180910390a36001905092915050565b5f604051905090565b5f5ffd5b5f5ffd5b5f5ffd5b5f5ffd5b5f601f19601f8301169050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52604160045260245ffd5b61034c82610306565b810181811067ffffffffffffffff8211171561036b5761036a610316565b5b80604052505050565b5f61037d6102ed565b90506103898282610343565b919050565b5f67ffffffffffffffff8211156103a8576103a7610316565b5b6103b182610306565b9050602081019050919050565b8281835e5f83830152505050565b5f6103de6103d98461038e565b610374565b9050828152602081018484840111156103fa576103f9610302565b5b6104058482856103be565b509392505050565b5f82601f830112610421576104206102fe565b5b81516104318482602086016103cc565b91505092915050565b5f5f604083850312156104505761044f6102f6565b5b5f83015167ffffffffffffffff81111561046d5761046c6102fa565b5b6104798582860161040d565b925050602083015167ffffffffffffffff81111561049a576104996102fa565b5b6104a68582860161040d565b9150509250929050565b5f81519050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52602260045260245ffd5b5f60028204905060018216806104fe57607f821691505b602082108103610511576105106104ba565b5b50919050565b5f819050815f5260205f209050919050565b5f6020601f8301049050919050565b5f82821b905092915050565b5f600883026105737fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff82610538565b61057d8683610538565b95508019841693508086168417925050509392505050565b5f819050919050565b5f819050919050565b5f6105c16105bc6105b784610595565b61059e565b610595565b9050919050565b5f819050919050565b6105da836105a7565b6105ee6105e6826105c8565b848454610544565b825550505050565b5f5f905090565b6106056105f6565b6106108184846105d1565b505050565b5b81811015610633576106285f826105fd565b600181019050610616565b5050565b601f8211156106785761064981610517565b61065284610529565b81016020851015610661578190505b61067561066d85610529565b830182610615565b50505b505050565b5f82821c905092915050565b5f6106985f198460080261067d565b1980831691505092915050565b5f6106b08383610689565b9150826002028217905092915050565b6106c9826104b0565b67ffffffffffffffff8111156106e2576106e1610316565b5b6106ec82546104e7565b6106f7828285610637565b5f60209050601f831160018114610728575f8415610716578287015190505b61072085826106a5565b865550610787565b601f19841661073686610517565b5f5b8281101561075d57848901518255600182019150602085019450602081019050610738565b8683101561077a5784890151610776601f891682610689565b8355505b6001600288020188555050505b505050505050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f8160011c9050919050565b5f5f8291508390505b6001851115610811578086048111156107ed576107ec61078f565b5b60018516156107fc5780820291505b808102905061080a856107bc565b94506107d1565b94509492505050565b5f8261082957600190506108e4565b81610836575f90506108e4565b816001811461084c576002811461085657610885565b60019150506108e4565b60ff8411156108685761086761078f565b5b8360020a91508482111561087f5761087e61078f565b5b506108e4565b5060208310610133831016604e8410600b84101617156108ba5782820a9050838111156108b5576108b461078f565b5b6108e4565b6108c784848460016107c8565b925090508184048111156108de576108dd61078f565b5b81810290505b9392505050565b5f60ff82169050919050565b5f61090182610595565b915061090c836108eb565b92506109397fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff848461081a565b905092915050565b5f61094b82610595565b915061095683610595565b925082820261096481610595565b9150828204841483151761097b5761097a61078f565b5b5092915050565b5f82825260208201905092915050565b7f4552433230546f6b656e3a206f6e6c79206f776e657220616c6c6f77656400005f82015250565b5f6109c6601e83610982565b91506109d182610992565b602082019050919050565b5f6020820190508181035f8301526109f3816109ba565b9050919050565b7f45524332303a206d696e7420746f20746865207a65726f2061646472657373005f82015250565b5f610a2e601f83610982565b9150610a39826109fa565b602082019050919050565b5f6020820190508181035f830152610a5b81610a22565b9050919050565b5f610a6c82610595565b9150610a7783610595565b9250828201905080821115610a8f57610a8e61078f565b5b92915050565b610a9e81610595565b82525050565b5f602082019050610ab75f830184610a95565b92915050565b61136380610aca5f395ff3fe608060405234801561000f575f5ffd5b5060043610610091575f3560e01c806340c10f191161006457806340c10f191461011f57806370a082311461014f57806395d89b411461017f578063a9059cbb1461019d578063e1f21c67146101cd57610091565b806306fdde031461009557806318160ddd146100b357806323b872dd146100d1578063313ce56714610101575b5f5ffd5b61009d6101fd565b6040516100aa9190610b92565b60405180910390f35b6100bb61028c565b6040516100c89190610bca565b60405180910390f35b6100eb60048036038101906100e69190610c6b565b610295565b6040516100f89190610cd5565b60405180910390f35b61010961034c565b6040516101169190610d09565b60405180910390f35b61013960048036038101906101349190610d22565b610361565b6040516101469190610cd5565b60405180910390f35b61016960048036038101906101649190610d60565b610539565b6040516101769190610bca565b60405180910390f35b61018761057f565b6040516101949190610b92565b60405180910390f35b6101b760048036038101906101b29190610d22565b61060f565b6040516101c49190610cd5565b60405180910390f35b6101e760048036038101906101e29190610c6b565b610623565b6040516101f49190610cd5565b60405180910390f35b60605f805461020b90610db8565b80601f016020809104026020016040519081016040528092919081815260200182805461023790610db8565b80156102825780601f1061025957610100808354040283529160200191610282565b820191905f5260205f20905b81548152906001019060200180831161026557829003601f168201915b5050505050905090565b5f600354905090565b5f5f61032585858560065f8a73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f3373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20546103209190610e15565b610623565b905080156103405761033885858561086d565b915050610345565b5f9150505b9392505050565b5f60025f9054906101000a900460ff16905090565b5f60055f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff16146103f1576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016103e890610e92565b60405180910390fd5b5f73ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff160361045f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161045690610efa565b60405180910390fd5b8160035f8282546104709190610f18565b925050819055508160045f8573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f8282546104c39190610f18565b925050819055508273ffffffffffffffffffffffffffffffffffffffff165f73ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516105279190610bca565b60405180910390a36001905092915050565b5f60045f8373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20549050919050565b60606001805461058e90610db8565b80601f01602080910402602001604051908101604052809291908181526020018280546105ba90610db8565b80156106055780601f106105dc57610100808354040283529160200191610605565b820191905f5260205f20905b8154815290600101906020018083116105e857829003601f168201915b5050505050905090565b5f61061b33848461086d565b905092915050565b5f5f73ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610692576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161068990610fbb565b60405180910390fd5b5f73ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff1603610700576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016106f790611049565b60405180910390fd5b8160045f8673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20541015610780576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610777906110d7565b60405180910390fd5b8160065f8673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f8573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9258460405161085a9190610bca565b60405180910390a3600190509392505050565b5f5f73ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff16036108dc576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016108d390611165565b60405180910390fd5b5f73ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff160361094a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610941906111f3565b60405180910390fd5b5f821161098c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161098390611281565b60405180910390fd5b8160045f8673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20541015610a0c576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a039061130f565b60405180910390fd5b8160045f8673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f828254610a589190610e15565b925050819055508160045f8573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f205f828254610aab9190610f18565b925050819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef84604051610b0f9190610bca565b60405180910390a3600190509392505050565b5f81519050919050565b5f82825260208201905092915050565b8281835e5f83830152505050565b5f601f19601f8301169050919050565b5f610b6482610b22565b610b6e8185610b2c565b9350610b7e818560208601610b3c565b610b8781610b4a565b840191505092915050565b5f6020820190508181035f830152610baa8184610b5a565b905092915050565b5f819050919050565b610bc481610bb2565b82525050565b5f602082019050610bdd5f830184610bbb565b92915050565b5f5ffd5b5f73ffffffffffffffffffffffffffffffffffffffff82169050919050565b5f610c1082610be7565b9050919050565b610c2081610c06565b8114610c2a575f5ffd5b50565b5f81359050610c3b81610c17565b92915050565b610c4a81610bb2565b8114610c54575f5ffd5b50565b5f81359050610c6581610c41565b92915050565b5f5f5f60608486031215610c8257610c81610be3565b5b5f610c8f86828701610c2d565b9350506020610ca086828701610c2d565b9250506040610cb186828701610c57565b9150509250925092565b5f8115159050919050565b610ccf81610cbb565b82525050565b5f602082019050610ce85f830184610cc6565b92915050565b5f60ff82169050919050565b610d0381610cee565b82525050565b5f602082019050610d1c5f830184610cfa565b92915050565b5f5f60408385031215610d3857610d37610be3565b5b5f610d4585828601610c2d565b9250506020610d5685828601610c57565b9150509250929050565b5f60208284031215610d7557610d74610be3565b5b5f610d8284828501610c2d565b91505092915050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52602260045260245ffd5b5f6002820490506001821680610dcf57607f821691505b602082108103610de257610de1610d8b565b5b50919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f610e1f82610bb2565b9150610e2a83610bb2565b9250828203905081811115610e4257610e41610de8565b5b92915050565b7f4552433230546f6b656e3a206f6e6c79206f776e657220616c6c6f77656400005f82015250565b5f610e7c601e83610b2c565b9150610e8782610e48565b602082019050919050565b5f6020820190508181035f830152610ea981610e70565b9050919050565b7f45524332303a206d696e7420746f20746865207a65726f2061646472657373005f82015250565b5f610ee4601f83610b2c565b9150610eef82610eb0565b602082019050919050565b5f6020820190508181035f830152610f1181610ed8565b9050919050565b5f610f2282610bb2565b9150610f2d83610bb2565b9250828201905080821115610f4557610f44610de8565b5b92915050565b7f45524332303a20617070726f7665207370656e64657220746865207a65726f205f8201527f6164647265737300000000000000000000000000000000000000000000000000602082015250565b5f610fa5602783610b2c565b9150610fb082610f4b565b604082019050919050565b5f6020820190508181035f830152610fd281610f99565b9050919050565b7f45524332303a20617070726f7665206f776e657220746865207a65726f2061645f8201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b5f611033602583610b2c565b915061103e82610fd9565b604082019050919050565b5f6020820190508181035f83015261106081611027565b9050919050565b7f45524332303a20617070726f766520616d6f756e74206d7573742062652067725f8201527f6561746572207468616e2062616c616e63650000000000000000000000000000602082015250565b5f6110c1603283610b2c565b91506110cc82611067565b604082019050919050565b5f6020820190508181035f8301526110ee816110b5565b9050919050565b7f45524332303a207472616e736665722066726f6d20746865207a65726f2061645f8201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b5f61114f602583610b2c565b915061115a826110f5565b604082019050919050565b5f6020820190508181035f83015261117c81611143565b9050919050565b7f45524332303a207472616e7366657220746f20746865207a65726f20616464725f8201527f6573730000000000000000000000000000000000000000000000000000000000602082015250565b5f6111dd602383610b2c565b91506111e882611183565b604082019050919050565b5f6020820190508181035f83015261120a816111d1565b9050919050565b7f45524332303a207472616e7366657220616d6f756e74206d75737420626520675f8201527f726561746572207468616e203000000000000000000000000000000000000000602082015250565b5f61126b602d83610b2c565b915061127682611211565b604082019050919050565b5f6020820190508181035f8301526112988161125f565b9050919050565b7f45524332303a207472616e7366657220616d6f756e74206578636565647320625f8201527f616c616e63650000000000000000000000000000000000000000000000000000602082015250565b5f6112f9602683610b2c565b91506113048261129f565b604082019050919050565b5f6020820190508181035f830152611326816112ed565b905091905056fea264697066735822122090e50b1449821b320b9f4e67be26930083e0f0b313ddbbc66cf2bc1fe17fa51b64736f6c634300081e0033",
+}
+
+// Erc201ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Erc201MetaData.ABI instead.
+var Erc201ABI = Erc201MetaData.ABI
+
+// Erc201Bin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Erc201MetaData.Bin instead.
+var Erc201Bin = Erc201MetaData.Bin
+
+// DeployErc201 deploys a new Ethereum contract, binding an instance of Erc201 to it.
+func DeployErc201(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string) (common.Address, *types.Transaction, *Erc201, error) {
+	parsed, err := Erc201MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Erc201Bin), backend, name_, symbol_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Erc201{Erc201Caller: Erc201Caller{contract: contract}, Erc201Transactor: Erc201Transactor{contract: contract}, Erc201Filterer: Erc201Filterer{contract: contract}}, nil
+}
+
+// Erc201 is an auto generated Go binding around an Ethereum contract.
+type Erc201 struct {
+	Erc201Caller     // Read-only binding to the contract
+	Erc201Transactor // Write-only binding to the contract
+	Erc201Filterer   // Log filterer for contract events
+}
+
+// Erc201Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Erc201Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Erc201Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Erc201Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Erc201Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Erc201Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Erc201Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Erc201Session struct {
+	Contract     *Erc201           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Erc201CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Erc201CallerSession struct {
+	Contract *Erc201Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// Erc201TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Erc201TransactorSession struct {
+	Contract     *Erc201Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Erc201Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Erc201Raw struct {
+	Contract *Erc201 // Generic contract binding to access the raw methods on
+}
+
+// Erc201CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Erc201CallerRaw struct {
+	Contract *Erc201Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Erc201TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Erc201TransactorRaw struct {
+	Contract *Erc201Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewErc201 creates a new instance of Erc201, bound to a specific deployed contract.
+func NewErc201(address common.Address, backend bind.ContractBackend) (*Erc201, error) {
+	contract, err := bindErc201(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Erc201{Erc201Caller: Erc201Caller{contract: contract}, Erc201Transactor: Erc201Transactor{contract: contract}, Erc201Filterer: Erc201Filterer{contract: contract}}, nil
+}
+
+// NewErc201Caller creates a new read-only instance of Erc201, bound to a specific deployed contract.
+func NewErc201Caller(address common.Address, caller bind.ContractCaller) (*Erc201Caller, error) {
+	contract, err := bindErc201(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Erc201Caller{contract: contract}, nil
+}
+
+// NewErc201Transactor creates a new write-only instance of Erc201, bound to a specific deployed contract.
+func NewErc201Transactor(address common.Address, transactor bind.ContractTransactor) (*Erc201Transactor, error) {
+	contract, err := bindErc201(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Erc201Transactor{contract: contract}, nil
+}
+
+// NewErc201Filterer creates a new log filterer instance of Erc201, bound to a specific deployed contract.
+func NewErc201Filterer(address common.Address, filterer bind.ContractFilterer) (*Erc201Filterer, error) {
+	contract, err := bindErc201(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Erc201Filterer{contract: contract}, nil
+}
+
+// bindErc201 binds a generic wrapper to an already deployed contract.
+func bindErc201(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Erc201MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Erc201 *Erc201Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Erc201.Contract.Erc201Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Erc201 *Erc201Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Erc201.Contract.Erc201Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Erc201 *Erc201Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Erc201.Contract.Erc201Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Erc201 *Erc201CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Erc201.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Erc201 *Erc201TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Erc201.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Erc201 *Erc201TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Erc201.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_Erc201 *Erc201Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Erc201.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_Erc201 *Erc201Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _Erc201.Contract.BalanceOf(&_Erc201.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_Erc201 *Erc201CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _Erc201.Contract.BalanceOf(&_Erc201.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_Erc201 *Erc201Caller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Erc201.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_Erc201 *Erc201Session) Decimals() (uint8, error) {
+	return _Erc201.Contract.Decimals(&_Erc201.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_Erc201 *Erc201CallerSession) Decimals() (uint8, error) {
+	return _Erc201.Contract.Decimals(&_Erc201.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Erc201 *Erc201Caller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Erc201.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Erc201 *Erc201Session) Name() (string, error) {
+	return _Erc201.Contract.Name(&_Erc201.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Erc201 *Erc201CallerSession) Name() (string, error) {
+	return _Erc201.Contract.Name(&_Erc201.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_Erc201 *Erc201Caller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Erc201.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_Erc201 *Erc201Session) Symbol() (string, error) {
+	return _Erc201.Contract.Symbol(&_Erc201.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_Erc201 *Erc201CallerSession) Symbol() (string, error) {
+	return _Erc201.Contract.Symbol(&_Erc201.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Erc201 *Erc201Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Erc201.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Erc201 *Erc201Session) TotalSupply() (*big.Int, error) {
+	return _Erc201.Contract.TotalSupply(&_Erc201.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Erc201 *Erc201CallerSession) TotalSupply() (*big.Int, error) {
+	return _Erc201.Contract.TotalSupply(&_Erc201.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xe1f21c67.
+//
+// Solidity: function approve(address owner, address spender, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Transactor) Approve(opts *bind.TransactOpts, owner common.Address, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.contract.Transact(opts, "approve", owner, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xe1f21c67.
+//
+// Solidity: function approve(address owner, address spender, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Session) Approve(owner common.Address, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.Approve(&_Erc201.TransactOpts, owner, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xe1f21c67.
+//
+// Solidity: function approve(address owner, address spender, uint256 amount) returns(bool)
+func (_Erc201 *Erc201TransactorSession) Approve(owner common.Address, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.Approve(&_Erc201.TransactOpts, owner, spender, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Transactor) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.contract.Transact(opts, "mint", to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Session) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.Mint(&_Erc201.TransactOpts, to, amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201TransactorSession) Mint(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.Mint(&_Erc201.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Transactor) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.contract.Transact(opts, "transfer", to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Session) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.Transfer(&_Erc201.TransactOpts, to, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201TransactorSession) Transfer(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.Transfer(&_Erc201.TransactOpts, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.contract.Transact(opts, "transferFrom", from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201Session) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.TransferFrom(&_Erc201.TransactOpts, from, to, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 amount) returns(bool)
+func (_Erc201 *Erc201TransactorSession) TransferFrom(from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Erc201.Contract.TransferFrom(&_Erc201.TransactOpts, from, to, amount)
+}
+
+// Erc201ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the Erc201 contract.
+type Erc201ApprovalIterator struct {
+	Event *Erc201Approval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Erc201ApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Erc201Approval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Erc201Approval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Erc201ApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Erc201ApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Erc201Approval represents a Approval event raised by the Erc201 contract.
+type Erc201Approval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_Erc201 *Erc201Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*Erc201ApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _Erc201.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Erc201ApprovalIterator{contract: _Erc201.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_Erc201 *Erc201Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *Erc201Approval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _Erc201.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Erc201Approval)
+				if err := _Erc201.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_Erc201 *Erc201Filterer) ParseApproval(log types.Log) (*Erc201Approval, error) {
+	event := new(Erc201Approval)
+	if err := _Erc201.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Erc201TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the Erc201 contract.
+type Erc201TransferIterator struct {
+	Event *Erc201Transfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Erc201TransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Erc201Transfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Erc201Transfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Erc201TransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Erc201TransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Erc201Transfer represents a Transfer event raised by the Erc201 contract.
+type Erc201Transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Erc201 *Erc201Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*Erc201TransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Erc201.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Erc201TransferIterator{contract: _Erc201.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Erc201 *Erc201Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *Erc201Transfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Erc201.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Erc201Transfer)
+				if err := _Erc201.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Erc201 *Erc201Filterer) ParseTransfer(log types.Log) (*Erc201Transfer, error) {
+	event := new(Erc201Transfer)
+	if err := _Erc201.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
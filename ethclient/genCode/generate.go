@@ -0,0 +1,18 @@
+package genCode
+
+/**
+合约绑定代码的生成命令，在 genCode 目录运行 go generate，需要先安装 solcjs
+    带上 --bin 生成的绑定包含字节码，可以在内存链上部署合约做测试
+    生成的 .bin 同时供 gasprof/tasks.json 使用，task1 算法合约的字节码供 algocheck 使用
+*/
+
+//go:generate solcjs --abi --bin --base-path ../../solidity_task/task1 -o . ../../solidity_task/task1/Voting.sol ../../solidity_task/task1/BinarySearch.sol ../../solidity_task/task1/IntToRoman.sol ../../solidity_task/task1/MergeSortedArray.sol ../../solidity_task/task1/ResverseString.sol ../../solidity_task/task1/RomanToInt.sol
+//go:generate solcjs --abi --bin --base-path ../../solidity_task/task2 -o . ../../solidity_task/task2/BeggingContract.sol ../../solidity_task/task2/Erc201.sol
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi Voting_sol_Voting.abi --bin Voting_sol_Voting.bin --pkg genCode --type Voting --out voting.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi BeggingContract_sol_BeggingContract.abi --bin BeggingContract_sol_BeggingContract.bin --pkg genCode --type BeggingContract --out begging_contract.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi Erc201_sol_Erc201.abi --bin Erc201_sol_Erc201.bin --pkg genCode --type Erc201 --out erc201.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi BinarySearch_sol_BinarySearch.abi --bin BinarySearch_sol_BinarySearch.bin --pkg genCode --type BinarySearch --out binary_search.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi IntToRoman_sol_IntToRoman.abi --bin IntToRoman_sol_IntToRoman.bin --pkg genCode --type IntToRoman --out int_to_roman.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi MergeSortedArray_sol_MergeSortedArray.abi --bin MergeSortedArray_sol_MergeSortedArray.bin --pkg genCode --type MergeSortedArray --out merge_sorted_array.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi ResverseString_sol_ReserseString.abi --bin ResverseString_sol_ReserseString.bin --pkg genCode --type ReserseString --out reserse_string.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen --abi RomanToInt_sol_RomanToInt.abi --bin RomanToInt_sol_RomanToInt.bin --pkg genCode --type RomanToInt --out roman_to_int.go
@@ -0,0 +1,276 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package genCode
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VotingMetaData contains all meta data concerning the Voting contract.
+var VotingMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"candidate\",\"type\":\"string\"}],\"name\":\"getVotes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetVotes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"candidate\",\"type\":\"string\"}],\"name\":\"vote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600e575f5ffd5b506107f08061001c5f395ff3fe608060405234801561000f575f5ffd5b506004361061003f575f3560e01c8063805265e514610043578063b9830ff114610073578063fc36e15b1461007d575b5f5ffd5b61005d600480360381019061005891906102c6565b610099565b60405161006a9190610329565b60405180910390f35b61007b6100c3565b005b610097600480360381019061009291906102c6565b610130565b005b5f600183836040516100ac92919061037e565b908152602001604051809103902054905092915050565b5f5f8054905090505f5f90505b818110156101205760015f82815481106100ed576100ec610396565b5b905f5260205f200160405161010291906104b2565b90815260200160405180910390205f905580806001019150506100d0565b505f5f61012d91906101c4565b50565b5f6001838360405161014392919061037e565b908152602001604051809103902054905060018161016191906104f5565b6001848460405161017392919061037e565b9081526020016040518091039020819055505f838390918060018154018082558091505060019003905f5260205f20015f9091929091929091929091925091826101be9291906106ed565b50505050565b5080545f8255905f5260205f20908101906101df91906101e2565b50565b5b80821115610201575f81816101f89190610205565b506001016101e3565b5090565b508054610211906103f0565b5f825580601f10610222575061023f565b601f0160209004905f5260205f209081019061023e9190610242565b5b50565b5b80821115610259575f815f905550600101610243565b5090565b5f5ffd5b5f5ffd5b5f5ffd5b5f5ffd5b5f5ffd5b5f5f83601f84011261028657610285610265565b5b8235905067ffffffffffffffff8111156102a3576102a2610269565b5b6020830191508360018202830111156102bf576102be61026d565b5b9250929050565b5f5f602083850312156102dc576102db61025d565b5b5f83013567ffffffffffffffff8111156102f9576102f8610261565b5b61030585828601610271565b92509250509250929050565b5f819050919050565b61032381610311565b82525050565b5f60208201905061033c5f83018461031a565b92915050565b5f81905092915050565b828183375f83830152505050565b5f6103658385610342565b935061037283858461034c565b82840190509392505050565b5f61038a82848661035a565b91508190509392505050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52603260045260245ffd5b7f4e487b71000000000000000000000000000000000000000000000000000000005f52602260045260245ffd5b5f600282049050600182168061040757607f821691505b60208210810361041a576104196103c3565b5b50919050565b5f819050815f5260205f209050919050565b5f815461043e816103f0565b6104488186610342565b9450600182165f81146104625760018114610477576104a9565b60ff19831686528115158202860193506104a9565b61048085610420565b5f5b838110156104a157815481890152600182019150602081019050610482565b838801955050505b50505092915050565b5f6104bd8284610432565b915081905092915050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f6104ff82610311565b915061050a83610311565b9250828201905080821115610522576105216104c8565b5b92915050565b5f82905092915050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52604160045260245ffd5b5f6020601f8301049050919050565b5f82821b905092915050565b5f600883026105a97fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8261056e565b6105b3868361056e565b95508019841693508086168417925050509392505050565b5f819050919050565b5f6105ee6105e96105e484610311565b6105cb565b610311565b9050919050565b5f819050919050565b610607836105d4565b61061b610613826105f5565b84845461057a565b825550505050565b5f5f905090565b610632610623565b61063d8184846105fe565b505050565b5b81811015610660576106555f8261062a565b600181019050610643565b5050565b601f8211156106a55761067681610420565b61067f8461055f565b8101602085101561068e578190505b6106a261069a8561055f565b830182610642565b50505b505050565b5f82821c905092915050565b5f6106c55f19846008026106aa565b1980831691505092915050565b5f6106dd83836106b6565b9150826002028217905092915050565b6106f78383610528565b67ffffffffffffffff8111156107105761070f610532565b5b61071a82546103f0565b610725828285610664565b5f601f831160018114610752575f8415610740578287013590505b61074a85826106d2565b8655506107b1565b601f19841661076086610420565b5f5b8281101561078757848901358255600182019150602085019450602081019050610762565b868310156107a457848901356107a0601f8916826106b6565b8355505b6001600288020188555050505b5050505050505056fea264697066735822122038583e7ba7c5cf24f3983a6867f3fc6283b9bb5c61a3b8986ce7612235e9f5ee64736f6c634300081e0033",
+}
+
+// VotingABI is the input ABI used to generate the binding from.
+// Deprecated: Use VotingMetaData.ABI instead.
+var VotingABI = VotingMetaData.ABI
+
+// VotingBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use VotingMetaData.Bin instead.
+var VotingBin = VotingMetaData.Bin
+
+// DeployVoting deploys a new Ethereum contract, binding an instance of Voting to it.
+func DeployVoting(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Voting, error) {
+	parsed, err := VotingMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(VotingBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Voting{VotingCaller: VotingCaller{contract: contract}, VotingTransactor: VotingTransactor{contract: contract}, VotingFilterer: VotingFilterer{contract: contract}}, nil
+}
+
+// Voting is an auto generated Go binding around an Ethereum contract.
+type Voting struct {
+	VotingCaller     // Read-only binding to the contract
+	VotingTransactor // Write-only binding to the contract
+	VotingFilterer   // Log filterer for contract events
+}
+
+// VotingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VotingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VotingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VotingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VotingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VotingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VotingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VotingSession struct {
+	Contract     *Voting           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VotingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VotingCallerSession struct {
+	Contract *VotingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// VotingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VotingTransactorSession struct {
+	Contract     *VotingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VotingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VotingRaw struct {
+	Contract *Voting // Generic contract binding to access the raw methods on
+}
+
+// VotingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VotingCallerRaw struct {
+	Contract *VotingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VotingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VotingTransactorRaw struct {
+	Contract *VotingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVoting creates a new instance of Voting, bound to a specific deployed contract.
+func NewVoting(address common.Address, backend bind.ContractBackend) (*Voting, error) {
+	contract, err := bindVoting(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Voting{VotingCaller: VotingCaller{contract: contract}, VotingTransactor: VotingTransactor{contract: contract}, VotingFilterer: VotingFilterer{contract: contract}}, nil
+}
+
+// NewVotingCaller creates a new read-only instance of Voting, bound to a specific deployed contract.
+func NewVotingCaller(address common.Address, caller bind.ContractCaller) (*VotingCaller, error) {
+	contract, err := bindVoting(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingCaller{contract: contract}, nil
+}
+
+// NewVotingTransactor creates a new write-only instance of Voting, bound to a specific deployed contract.
+func NewVotingTransactor(address common.Address, transactor bind.ContractTransactor) (*VotingTransactor, error) {
+	contract, err := bindVoting(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingTransactor{contract: contract}, nil
+}
+
+// NewVotingFilterer creates a new log filterer instance of Voting, bound to a specific deployed contract.
+func NewVotingFilterer(address common.Address, filterer bind.ContractFilterer) (*VotingFilterer, error) {
+	contract, err := bindVoting(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VotingFilterer{contract: contract}, nil
+}
+
+// bindVoting binds a generic wrapper to an already deployed contract.
+func bindVoting(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VotingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Voting *VotingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Voting.Contract.VotingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Voting *VotingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Voting.Contract.VotingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Voting *VotingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Voting.Contract.VotingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Voting *VotingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Voting.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Voting *VotingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Voting.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Voting *VotingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Voting.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0x805265e5.
+//
+// Solidity: function getVotes(string candidate) view returns(uint256)
+func (_Voting *VotingCaller) GetVotes(opts *bind.CallOpts, candidate string) (*big.Int, error) {
+	var out []interface{}
+	err := _Voting.contract.Call(opts, &out, "getVotes", candidate)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0x805265e5.
+//
+// Solidity: function getVotes(string candidate) view returns(uint256)
+func (_Voting *VotingSession) GetVotes(candidate string) (*big.Int, error) {
+	return _Voting.Contract.GetVotes(&_Voting.CallOpts, candidate)
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0x805265e5.
+//
+// Solidity: function getVotes(string candidate) view returns(uint256)
+func (_Voting *VotingCallerSession) GetVotes(candidate string) (*big.Int, error) {
+	return _Voting.Contract.GetVotes(&_Voting.CallOpts, candidate)
+}
+
+// ResetVotes is a paid mutator transaction binding the contract method 0xb9830ff1.
+//
+// Solidity: function resetVotes() returns()
+func (_Voting *VotingTransactor) ResetVotes(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Voting.contract.Transact(opts, "resetVotes")
+}
+
+// ResetVotes is a paid mutator transaction binding the contract method 0xb9830ff1.
+//
+// Solidity: function resetVotes() returns()
+func (_Voting *VotingSession) ResetVotes() (*types.Transaction, error) {
+	return _Voting.Contract.ResetVotes(&_Voting.TransactOpts)
+}
+
+// ResetVotes is a paid mutator transaction binding the contract method 0xb9830ff1.
+//
+// Solidity: function resetVotes() returns()
+func (_Voting *VotingTransactorSession) ResetVotes() (*types.Transaction, error) {
+	return _Voting.Contract.ResetVotes(&_Voting.TransactOpts)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xfc36e15b.
+//
+// Solidity: function vote(string candidate) returns()
+func (_Voting *VotingTransactor) Vote(opts *bind.TransactOpts, candidate string) (*types.Transaction, error) {
+	return _Voting.contract.Transact(opts, "vote", candidate)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xfc36e15b.
+//
+// Solidity: function vote(string candidate) returns()
+func (_Voting *VotingSession) Vote(candidate string) (*types.Transaction, error) {
+	return _Voting.Contract.Vote(&_Voting.TransactOpts, candidate)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0xfc36e15b.
+//
+// Solidity: function vote(string candidate) returns()
+func (_Voting *VotingTransactorSession) Vote(candidate string) (*types.Transaction, error) {
+	return _Voting.Contract.Vote(&_Voting.TransactOpts, candidate)
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"crypto/ecdsa"
+	"ethclient/units"
 	"fmt"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,7 +14,6 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"golang.org/x/crypto/sha3"
 	"log"
-	"math"
 	"math/big"
 )
 
@@ -284,9 +284,11 @@ func transferTokenMain() {
 	methodID := hash.Sum(nil)[:4]
 	fmt.Println(hexutil.Encode(methodID)) // 0xa9059cbb
 	paddedAddress := common.LeftPadBytes(toAddress.Bytes(), 32)
-	fmt.Println(hexutil.Encode(paddedAddress)) // 0x0000000000000000000000004592d8f8d7b001e72cb26a73e4fa1806a51ac79d
-	amount := new(big.Int)
-	amount.SetString("1000000000000000000000", 10) // 1000 tokens
+	fmt.Println(hexutil.Encode(paddedAddress))  // 0x0000000000000000000000004592d8f8d7b001e72cb26a73e4fa1806a51ac79d
+	amount, err := units.ParseUnits("1000", 18) // 1000 tokens
+	if err != nil {
+		log.Fatal(err)
+	}
 	paddedAmount := common.LeftPadBytes(amount.Bytes(), 32)
 	fmt.Println(hexutil.Encode(paddedAmount)) // 0x00000000000000000000000000000000000000000000003635c9adc5dea00000
 	var data []byte
@@ -340,11 +342,8 @@ func searchBalanceMain() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	fmt.Println(balanceAt) // 25729324269165216042
-	fbalance := new(big.Float)
-	fbalance.SetString(balanceAt.String())
-	ethValue := new(big.Float).Quo(fbalance, big.NewFloat(math.Pow10(18)))
-	fmt.Println(ethValue) // 25.729324269165216041
+	fmt.Println(balanceAt)                    // 25729324269165216042
+	fmt.Println(units.FormatEther(balanceAt)) // 25.729324269165216042 ether
 	pendingBalance, err := client.PendingBalanceAt(context.Background(), account)
 	fmt.Println(pendingBalance) // 25729324269165216042
 }
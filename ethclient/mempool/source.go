@@ -0,0 +1,161 @@
+package mempool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Tx 一笔待处理交易和它的发送者
+type Tx struct {
+	Tx   *types.Transaction
+	From common.Address
+}
+
+// Source 待处理交易的来源
+type Source interface {
+	// Run 把新出现的待处理交易写入 out，直到 ctx 结束或出错
+	Run(ctx context.Context, out chan<- Tx) error
+}
+
+// NewSource 节点支持订阅(ws/ipc)时使用 newPendingTransactions，否则轮询 txpool_content
+func NewSource(ctx context.Context, client *rpc.Client, interval time.Duration) Source {
+	probe := make(chan common.Hash)
+	sub, err := client.EthSubscribe(ctx, probe, "newPendingTransactions")
+	if err == nil {
+		sub.Unsubscribe()
+		return &SubscribeSource{client: client}
+	}
+	if !errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		log.Printf("订阅 newPendingTransactions 失败，改为轮询 txpool_content: %v", err)
+	}
+	return &PollSource{client: client, interval: interval}
+}
+
+// SubscribeSource 订阅 newPendingTransactions 得到交易哈希，再查询交易内容
+type SubscribeSource struct {
+	client *rpc.Client
+}
+
+func NewSubscribeSource(client *rpc.Client) *SubscribeSource {
+	return &SubscribeSource{client: client}
+}
+
+func (s *SubscribeSource) Run(ctx context.Context, out chan<- Tx) error {
+	eth := ethclient.NewClient(s.client)
+	chainID, err := eth.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+
+	hashes := make(chan common.Hash, 256)
+	sub, err := s.client.EthSubscribe(ctx, hashes, "newPendingTransactions")
+	if err != nil {
+		return fmt.Errorf("订阅 newPendingTransactions 失败: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return err
+		case hash := <-hashes:
+			tx, _, err := eth.TransactionByHash(ctx, hash)
+			if err != nil {
+				// 交易可能已被打包或丢弃
+				if !errors.Is(err, ethereum.NotFound) {
+					log.Printf("查询待处理交易 %s 失败: %v", hash.Hex(), err)
+				}
+				continue
+			}
+			from, err := types.Sender(signer, tx)
+			if err != nil {
+				log.Printf("获取交易 %s 发送者失败: %v", hash.Hex(), err)
+				continue
+			}
+			select {
+			case out <- Tx{Tx: tx, From: from}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// PollSource 定时轮询 txpool_content，只输出上次轮询之后新出现的交易
+type PollSource struct {
+	client   *rpc.Client
+	interval time.Duration
+}
+
+func NewPollSource(client *rpc.Client, interval time.Duration) *PollSource {
+	return &PollSource{client: client, interval: interval}
+}
+
+// txpool_content 返回的交易，交易字段和 eth_getTransactionByHash 一致
+type poolTx struct {
+	From common.Address `json:"from"`
+}
+
+type poolContent struct {
+	Pending map[common.Address]map[string]json.RawMessage `json:"pending"`
+	Queued  map[common.Address]map[string]json.RawMessage `json:"queued"`
+}
+
+func (s *PollSource) Run(ctx context.Context, out chan<- Tx) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	seen := make(map[common.Hash]bool)
+	for {
+		var content poolContent
+		if err := s.client.CallContext(ctx, &content, "txpool_content"); err != nil {
+			return fmt.Errorf("调用 txpool_content 失败: %w", err)
+		}
+		// 只保留当前仍在交易池中的哈希，已打包或丢弃的交易不再占用内存
+		current := make(map[common.Hash]bool, len(seen))
+		for _, txs := range []map[common.Address]map[string]json.RawMessage{content.Pending, content.Queued} {
+			for _, byNonce := range txs {
+				for _, raw := range byNonce {
+					tx := new(types.Transaction)
+					if err := tx.UnmarshalJSON(raw); err != nil {
+						log.Printf("解析 txpool 交易失败: %v", err)
+						continue
+					}
+					current[tx.Hash()] = true
+					if seen[tx.Hash()] {
+						continue
+					}
+					var sender poolTx
+					if err := json.Unmarshal(raw, &sender); err != nil {
+						continue
+					}
+					select {
+					case out <- Tx{Tx: tx, From: sender.From}:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+			}
+		}
+		seen = current
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package mempool
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"ethclient/genCode"
+	"ethclient/txdecode"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+待处理交易监听
+    通过 newPendingTransactions 订阅(节点不支持订阅时轮询 txpool_content)获取交易池中的新交易
+    只保留发往关注合约(如 AuctionFactory 创建的拍卖)的交易，并按已知 ABI 解码 calldata
+    在交易打包之前把待处理的出价等调用推送给订阅者
+*/
+
+// Pending 一笔发往关注合约的待处理交易
+type Pending struct {
+	Hash     common.Hash    `json:"hash"`
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Label    string         `json:"label,omitempty"`
+	Value    *big.Int       `json:"value"`
+	Nonce    uint64         `json:"nonce"`
+	Gas      uint64         `json:"gas"`
+	GasPrice *big.Int       `json:"gasPrice,omitempty"`
+	// EIP-1559 交易的小费和最高费用
+	GasTipCap *big.Int `json:"maxPriorityFeePerGas,omitempty"`
+	GasFeeCap *big.Int `json:"maxFeePerGas,omitempty"`
+	// 无法解码 calldata 时为空
+	Call   *txdecode.Call `json:"call,omitempty"`
+	SeenAt time.Time      `json:"seenAt"`
+}
+
+// Watcher 过滤交易池中发往关注合约的交易，推送给所有订阅者
+type Watcher struct {
+	source   Source
+	registry *txdecode.Registry
+
+	lock        sync.RWMutex
+	tracked     map[common.Address]string
+	methods     map[string]bool
+	subscribers map[int]chan *Pending
+	nextID      int
+}
+
+func NewWatcher(source Source, registry *txdecode.Registry) *Watcher {
+	return &Watcher{
+		source:      source,
+		registry:    registry,
+		tracked:     make(map[common.Address]string),
+		subscribers: make(map[int]chan *Pending),
+	}
+}
+
+// Track 关注发往 address 的交易，label 用于输出中标识合约
+func (w *Watcher) Track(address common.Address, label string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.tracked[address] = label
+}
+
+// FilterMethods 只推送调用这些方法(如 placeBidETH、placeBidERC20)的交易，为空时推送全部
+func (w *Watcher) FilterMethods(methods ...string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if len(methods) == 0 {
+		w.methods = nil
+		return
+	}
+	w.methods = make(map[string]bool, len(methods))
+	for _, method := range methods {
+		w.methods[method] = true
+	}
+}
+
+// TrackFactory 关注 AuctionFactory 当前创建的全部拍卖，返回新增的数量
+// 工厂之后创建的拍卖需要再次调用
+func (w *Watcher) TrackFactory(ctx context.Context, factory common.Address, backend bind.ContractCaller) (int, error) {
+	caller, err := genCode.NewAuctionFactoryCaller(factory, backend)
+	if err != nil {
+		return 0, err
+	}
+	auctions, err := caller.GetAuctions(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	added := 0
+	for _, auction := range auctions {
+		if _, ok := w.tracked[auction]; !ok {
+			w.tracked[auction] = "Auction"
+			added++
+		}
+	}
+	return added, nil
+}
+
+// Subscribe 订阅待处理交易，buffer 为通道缓冲大小；订阅者处理不及时时丢弃交易而不阻塞其他订阅者
+// 返回的函数用于取消订阅，取消后通道被关闭
+func (w *Watcher) Subscribe(buffer int) (<-chan *Pending, func()) {
+	ch := make(chan *Pending, buffer)
+	w.lock.Lock()
+	id := w.nextID
+	w.nextID++
+	w.subscribers[id] = ch
+	w.lock.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			w.lock.Lock()
+			delete(w.subscribers, id)
+			w.lock.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Run 从 source 读取交易并推送，直到 ctx 结束或 source 出错
+func (w *Watcher) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	txs := make(chan Tx, 256)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- w.source.Run(ctx, txs)
+	}()
+
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		case tx := <-txs:
+			if pending := w.match(tx); pending != nil {
+				w.publish(pending)
+			}
+		}
+	}
+}
+
+// match 交易发往关注的合约且通过方法过滤时返回 Pending
+func (w *Watcher) match(tx Tx) *Pending {
+	to := tx.Tx.To()
+	if to == nil {
+		return nil
+	}
+	w.lock.RLock()
+	label, ok := w.tracked[*to]
+	methods := w.methods
+	w.lock.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	call, err := w.registry.DecodeCalldata(tx.Tx.Data())
+	if methods != nil && (err != nil || !methods[call.Method]) {
+		return nil
+	}
+	if err != nil && len(tx.Tx.Data()) > 0 {
+		log.Printf("解码待处理交易 %s 失败: %v", tx.Tx.Hash().Hex(), err)
+	}
+
+	pending := &Pending{
+		Hash:   tx.Tx.Hash(),
+		From:   tx.From,
+		To:     *to,
+		Label:  label,
+		Value:  tx.Tx.Value(),
+		Nonce:  tx.Tx.Nonce(),
+		Gas:    tx.Tx.Gas(),
+		Call:   call,
+		SeenAt: time.Now(),
+	}
+	switch tx.Tx.Type() {
+	case types.LegacyTxType, types.AccessListTxType:
+		pending.GasPrice = tx.Tx.GasPrice()
+	default:
+		pending.GasTipCap = tx.Tx.GasTipCap()
+		pending.GasFeeCap = tx.Tx.GasFeeCap()
+	}
+	return pending
+}
+
+func (w *Watcher) publish(pending *Pending) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	for id, ch := range w.subscribers {
+		select {
+		case ch <- pending:
+		default:
+			log.Printf("订阅者 %d 处理不及时，丢弃待处理交易 %s", id, pending.Hash.Hex())
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package nftindex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+/**
+NFT 持有关系与元数据服务
+    索引 NftToken（以及 solidity_task/task2/NTF.sol 这类 ERC721URIStorage 合约）的 Transfer 事件，
+    维护 owner -> tokens 表；
+    读取 tokenURI 并通过可替换的 Resolver 拉取、缓存元数据 JSON；
+    通过 HTTP 提供按地址查询持有 token 的接口。
+*/
+
+// 每次 FilterTransfer 查询的区块范围，避免节点拒绝过大的日志查询
+const defaultBatch = 2000
+
+// Token 对外返回的 token 信息
+type Token struct {
+	ID       *big.Int  `json:"tokenId"`
+	URI      string    `json:"tokenURI"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+type Indexer struct {
+	backend  bind.ContractBackend
+	contract common.Address
+	nft      *genCode.NftToken
+	store    *Store
+	cache    *MetadataCache
+	batch    uint64
+
+	// tokenURI 设置后不会变化，按 tokenID 缓存
+	uriLock sync.RWMutex
+	uris    map[string]string
+}
+
+// NewIndexer 从 startBlock 开始索引 contract 的转移事件
+func NewIndexer(backend bind.ContractBackend, contract common.Address, startBlock uint64, cache *MetadataCache) (*Indexer, error) {
+	nft, err := genCode.NewNftToken(contract, backend)
+	if err != nil {
+		return nil, err
+	}
+	store := NewStore()
+	store.setNext(startBlock)
+	return &Indexer{
+		backend:  backend,
+		contract: contract,
+		nft:      nft,
+		store:    store,
+		cache:    cache,
+		batch:    defaultBatch,
+		uris:     make(map[string]string),
+	}, nil
+}
+
+func (ix *Indexer) Store() *Store {
+	return ix.store
+}
+
+// Sync 处理从下一个待处理区块到 head 之间的 Transfer 事件
+func (ix *Indexer) Sync(ctx context.Context, head uint64) error {
+	for from := ix.store.Next(); from <= head; {
+		to := from + ix.batch - 1
+		if to > head {
+			to = head
+		}
+		end := to
+		iter, err := ix.nft.FilterTransfer(&bind.FilterOpts{Start: from, End: &end, Context: ctx}, nil, nil, nil)
+		if err != nil {
+			return fmt.Errorf("查询区块 %d-%d 的 Transfer 事件失败: %w", from, to, err)
+		}
+		for iter.Next() {
+			ix.store.Apply(iter.Event.From, iter.Event.To, iter.Event.TokenId)
+		}
+		err = iter.Error()
+		iter.Close()
+		if err != nil {
+			return err
+		}
+		from = to + 1
+		ix.store.setNext(from)
+	}
+	return nil
+}
+
+// Run 按 interval 轮询最新区块并同步，直到 ctx 结束
+func (ix *Indexer) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		header, err := ix.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			log.Printf("获取最新区块失败: %v", err)
+		} else if err := ix.Sync(ctx, header.Number.Uint64()); err != nil {
+			log.Printf("同步 Transfer 事件失败: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// TokensOf 返回持有者的 token，withMetadata 为 true 时同时解析元数据
+func (ix *Indexer) TokensOf(ctx context.Context, owner common.Address, withMetadata bool) []*Token {
+	ids := ix.store.TokensOf(owner)
+	tokens := make([]*Token, 0, len(ids))
+	for _, id := range ids {
+		token := &Token{ID: id}
+		tokens = append(tokens, token)
+
+		uri, err := ix.tokenURI(ctx, id)
+		if err != nil {
+			token.Error = err.Error()
+			continue
+		}
+		token.URI = uri
+		if !withMetadata || ix.cache == nil {
+			continue
+		}
+		if token.Metadata, err = ix.cache.Get(ctx, uri); err != nil {
+			token.Error = err.Error()
+		}
+	}
+	return tokens
+}
+
+func (ix *Indexer) tokenURI(ctx context.Context, id *big.Int) (string, error) {
+	key := id.String()
+	ix.uriLock.RLock()
+	uri, ok := ix.uris[key]
+	ix.uriLock.RUnlock()
+	if ok {
+		return uri, nil
+	}
+
+	uri, err := ix.nft.TokenURI(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return "", fmt.Errorf("读取 tokenURI 失败: %w", err)
+	}
+	ix.uriLock.Lock()
+	ix.uris[key] = uri
+	ix.uriLock.Unlock()
+	return uri, nil
+}
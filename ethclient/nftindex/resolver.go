@@ -0,0 +1,233 @@
+package nftindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultHTTPTimeout 未指定 Client 时单次请求的超时时间
+const defaultHTTPTimeout = 10 * time.Second
+
+var (
+	ErrPathOutsideRoot = errors.New("nftindex: tokenURI 路径不在元数据目录内")
+	ErrHostNotAllowed  = errors.New("nftindex: tokenURI 主机不在允许列表中")
+)
+
+// Metadata tokenURI 指向的 JSON，字段参考 https://docs.opensea.io/docs/metadata-standards
+type Metadata struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Image       string      `json:"image"`
+	ExternalURL string      `json:"external_url,omitempty"`
+	Attributes  []Attribute `json:"attributes,omitempty"`
+}
+
+type Attribute struct {
+	TraitType string      `json:"trait_type"`
+	Value     interface{} `json:"value"`
+}
+
+// Resolver 根据 tokenURI 读取元数据原文
+type Resolver interface {
+	Resolve(ctx context.Context, uri string) ([]byte, error)
+}
+
+// FileResolver 读取 file:// 或本地路径，只允许读取 Root 目录内的文件
+// tokenURI 由合约控制，绝对路径、跳出 Root 的相对路径和指向 Root 外的符号链接都会被拒绝
+type FileResolver struct {
+	Root string
+}
+
+func (r *FileResolver) Resolve(ctx context.Context, uri string) ([]byte, error) {
+	path := strings.TrimPrefix(uri, "file://")
+	if r.Root == "" || filepath.IsAbs(path) {
+		return nil, ErrPathOutsideRoot
+	}
+	rel, err := filepath.Rel(r.Root, filepath.Join(r.Root, path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, ErrPathOutsideRoot
+	}
+
+	// os.Root 在打开文件时同样拒绝跳出目录的符号链接
+	root, err := os.OpenRoot(r.Root)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+	f, err := root.Open(rel)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// HTTPResolver 读取 http(s):// 地址
+// tokenURI 由合约控制，部署在内网时应设置 AllowedHosts，避免被用来访问内网地址
+type HTTPResolver struct {
+	// 为 nil 时使用带超时的默认 Client
+	Client *http.Client
+	// 元数据大小上限，0 表示使用默认值
+	MaxSize int64
+	// 允许访问的主机名，不含端口，为空表示不限制；重定向的目标同样需要在列表中
+	AllowedHosts []string
+}
+
+func (r *HTTPResolver) Resolve(ctx context.Context, uri string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkHost(req.URL); err != nil {
+		return nil, err
+	}
+	resp, err := r.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("请求 %s 失败: %s", uri, resp.Status)
+	}
+	maxSize := r.MaxSize
+	if maxSize <= 0 {
+		maxSize = 1 << 20
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxSize))
+}
+
+// client 返回实际使用的 Client，设置了 AllowedHosts 时在重定向前检查目标主机
+func (r *HTTPResolver) client() *http.Client {
+	client := &http.Client{Timeout: defaultHTTPTimeout}
+	if r.Client != nil {
+		copied := *r.Client
+		client = &copied
+	}
+	if len(r.AllowedHosts) > 0 {
+		next := client.CheckRedirect
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if err := r.checkHost(req.URL); err != nil {
+				return err
+			}
+			if next != nil {
+				return next(req, via)
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		}
+	}
+	return client
+}
+
+func (r *HTTPResolver) checkHost(u *url.URL) error {
+	if len(r.AllowedHosts) == 0 {
+		return nil
+	}
+	if !slices.ContainsFunc(r.AllowedHosts, func(host string) bool {
+		return strings.EqualFold(host, u.Hostname())
+	}) {
+		return fmt.Errorf("%w: %s", ErrHostNotAllowed, u.Hostname())
+	}
+	return nil
+}
+
+// IPFSResolver 把 ipfs://CID/path 转换成网关地址后通过 HTTP 读取
+type IPFSResolver struct {
+	// 例如 https://ipfs.io/ipfs/
+	Gateway string
+	HTTP    HTTPResolver
+}
+
+func (r *IPFSResolver) Resolve(ctx context.Context, uri string) ([]byte, error) {
+	path := strings.TrimPrefix(uri, "ipfs://")
+	// 兼容 ipfs://ipfs/CID 的写法
+	path = strings.TrimPrefix(path, "ipfs/")
+	gateway := r.Gateway
+	if gateway == "" {
+		gateway = "https://ipfs.io/ipfs/"
+	}
+	if !strings.HasSuffix(gateway, "/") {
+		gateway += "/"
+	}
+	return r.HTTP.Resolve(ctx, gateway+path)
+}
+
+// SchemeResolver 按 URI scheme 分发到不同的 Resolver，没有 scheme 的按文件处理
+type SchemeResolver map[string]Resolver
+
+// NewDefaultResolver 支持 file、http、https、ipfs，allowedHosts 限制 http(s) tokenURI 可以访问的主机
+// ipfs:// 只访问配置的网关，不受 allowedHosts 限制
+func NewDefaultResolver(root, gateway string, allowedHosts []string) SchemeResolver {
+	httpResolver := &HTTPResolver{AllowedHosts: allowedHosts}
+	fileResolver := &FileResolver{Root: root}
+	return SchemeResolver{
+		"":      fileResolver,
+		"file":  fileResolver,
+		"http":  httpResolver,
+		"https": httpResolver,
+		"ipfs":  &IPFSResolver{Gateway: gateway},
+	}
+}
+
+func (r SchemeResolver) Resolve(ctx context.Context, uri string) ([]byte, error) {
+	scheme := ""
+	if i := strings.Index(uri, "://"); i > 0 {
+		scheme = strings.ToLower(uri[:i])
+	}
+	resolver, ok := r[scheme]
+	if !ok {
+		return nil, fmt.Errorf("不支持的 tokenURI: %s", uri)
+	}
+	return resolver.Resolve(ctx, uri)
+}
+
+// MetadataCache 按 tokenURI 缓存解析后的元数据，失败结果不缓存
+type MetadataCache struct {
+	resolver Resolver
+	lock     sync.RWMutex
+	entries  map[string]*Metadata
+}
+
+func NewMetadataCache(resolver Resolver) *MetadataCache {
+	return &MetadataCache{
+		resolver: resolver,
+		entries:  make(map[string]*Metadata),
+	}
+}
+
+func (c *MetadataCache) Get(ctx context.Context, uri string) (*Metadata, error) {
+	c.lock.RLock()
+	meta, ok := c.entries[uri]
+	c.lock.RUnlock()
+	if ok {
+		return meta, nil
+	}
+
+	raw, err := c.resolver.Resolve(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	meta = new(Metadata)
+	if err := json.Unmarshal(raw, meta); err != nil {
+		return nil, fmt.Errorf("解析 %s 元数据失败: %w", uri, err)
+	}
+
+	c.lock.Lock()
+	c.entries[uri] = meta
+	c.lock.Unlock()
+	return meta, nil
+}
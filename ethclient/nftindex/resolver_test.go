@@ -0,0 +1,163 @@
+package nftindex
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileResolver(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "metadata")
+	if err := os.MkdirAll(filepath.Join(root, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	secret := filepath.Join(dir, "secret.json")
+	for path, content := range map[string]string{
+		filepath.Join(root, "1.json"):        `{"name":"1"}`,
+		filepath.Join(root, "sub", "2.json"): `{"name":"2"}`,
+		secret:                               `{"name":"secret"}`,
+	} {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Root 内指向 Root 外的符号链接
+	if err := os.Symlink(secret, filepath.Join(root, "link.json")); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &FileResolver{Root: root}
+	ctx := context.Background()
+	for uri, want := range map[string]string{
+		"1.json":              `{"name":"1"}`,
+		"file://1.json":       `{"name":"1"}`,
+		"sub/2.json":          `{"name":"2"}`,
+		"sub/../sub/2.json":   `{"name":"2"}`,
+		"file://sub/2.json":   `{"name":"2"}`,
+		"./sub/./2.json":      `{"name":"2"}`,
+		"sub/../1.json":       `{"name":"1"}`,
+		"file://./sub/2.json": `{"name":"2"}`,
+	} {
+		got, err := r.Resolve(ctx, uri)
+		if err != nil || string(got) != want {
+			t.Errorf("Resolve(%q) = %s, %v, want %s", uri, got, err, want)
+		}
+	}
+
+	for _, uri := range []string{
+		"../secret.json",
+		"file://../secret.json",
+		"sub/../../secret.json",
+		"..",
+		secret,
+		"file://" + secret,
+		"/etc/passwd",
+	} {
+		if _, err := r.Resolve(ctx, uri); !errors.Is(err, ErrPathOutsideRoot) {
+			t.Errorf("Resolve(%q) error = %v, want ErrPathOutsideRoot", uri, err)
+		}
+	}
+
+	// 路径本身在 Root 内，由 os.Root 拒绝跟随符号链接跳出目录
+	if got, err := r.Resolve(ctx, "link.json"); err == nil {
+		t.Errorf("Resolve(link.json) = %s, want error", got)
+	}
+
+	// 没有配置 Root 时不读取任何文件
+	if _, err := (&FileResolver{}).Resolve(ctx, "1.json"); !errors.Is(err, ErrPathOutsideRoot) {
+		t.Errorf("Root 为空时 error = %v, want ErrPathOutsideRoot", err)
+	}
+}
+
+func TestHTTPResolverAllowedHosts(t *testing.T) {
+	var target *httptest.Server
+	target = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/redirect":
+			// 127.0.0.1 和 localhost 是同一台机器，但主机名不同
+			http.Redirect(w, req, strings.Replace(target.URL, "127.0.0.1", "localhost", 1)+"/1.json", http.StatusFound)
+		default:
+			w.Write([]byte(`{"name":"1"}`))
+		}
+	}))
+	defer target.Close()
+	ctx := context.Background()
+
+	// 不限制时可以访问任意主机
+	if got, err := (&HTTPResolver{}).Resolve(ctx, target.URL+"/1.json"); err != nil || string(got) != `{"name":"1"}` {
+		t.Fatalf("Resolve = %s, %v", got, err)
+	}
+
+	r := &HTTPResolver{AllowedHosts: []string{"127.0.0.1"}}
+	if got, err := r.Resolve(ctx, target.URL+"/1.json"); err != nil || string(got) != `{"name":"1"}` {
+		t.Fatalf("Resolve = %s, %v", got, err)
+	}
+	if _, err := r.Resolve(ctx, target.URL+"/redirect"); !errors.Is(err, ErrHostNotAllowed) {
+		t.Fatalf("重定向到 localhost error = %v, want ErrHostNotAllowed", err)
+	}
+
+	// 不在列表中的主机在发出请求之前被拒绝
+	requested := false
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requested = true
+	}))
+	defer other.Close()
+	r = &HTTPResolver{AllowedHosts: []string{"metadata.example.com"}}
+	if _, err := r.Resolve(ctx, other.URL+"/1.json"); !errors.Is(err, ErrHostNotAllowed) {
+		t.Fatalf("Resolve error = %v, want ErrHostNotAllowed", err)
+	}
+	if requested {
+		t.Fatal("不在允许列表中的主机收到了请求")
+	}
+}
+
+func TestHTTPResolverTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-done:
+		case <-req.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	r := &HTTPResolver{Client: &http.Client{Timeout: 50 * time.Millisecond}, AllowedHosts: []string{"127.0.0.1"}}
+	start := time.Now()
+	_, err := r.Resolve(context.Background(), server.URL+"/1.json")
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("Resolve error = %v, want timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("超时后 %s 才返回", elapsed)
+	}
+
+	// 未指定 Client 时使用默认超时，设置 AllowedHosts 不会丢掉调用方 Client 的超时
+	if timeout := (&HTTPResolver{}).client().Timeout; timeout != defaultHTTPTimeout {
+		t.Fatalf("默认超时 = %s, want %s", timeout, defaultHTTPTimeout)
+	}
+	if timeout := r.client().Timeout; timeout != 50*time.Millisecond {
+		t.Fatalf("超时 = %s, want 50ms", timeout)
+	}
+}
+
+func TestHTTPResolverMaxSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(strings.Repeat("a", 100)))
+	}))
+	defer server.Close()
+
+	got, err := (&HTTPResolver{MaxSize: 10}).Resolve(context.Background(), server.URL)
+	if err != nil || len(got) != 10 {
+		t.Fatalf("Resolve = %d 字节, %v, want 10", len(got), err)
+	}
+}
@@ -0,0 +1,47 @@
+package nftindex
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OwnedTokensResp GET /owners/{address}/tokens 的返回
+type OwnedTokensResp struct {
+	Owner common.Address `json:"owner"`
+	// 结果包含 [startBlock, NextBlock) 范围内的转移
+	NextBlock uint64   `json:"nextBlock"`
+	Tokens    []*Token `json:"tokens"`
+}
+
+// Handler 返回 HTTP 路由
+//
+//	GET /owners/{address}/tokens?metadata=false  metadata=false 时不拉取元数据
+func (ix *Indexer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /owners/{address}/tokens", ix.ownedTokensHandler)
+	return mux
+}
+
+func (ix *Indexer) ownedTokensHandler(w http.ResponseWriter, r *http.Request) {
+	address := r.PathValue("address")
+	if !common.IsHexAddress(address) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "无效的地址"})
+		return
+	}
+	owner := common.HexToAddress(address)
+	withMetadata := r.URL.Query().Get("metadata") != "false"
+
+	writeJSON(w, http.StatusOK, &OwnedTokensResp{
+		Owner:     owner,
+		NextBlock: ix.store.Next(),
+		Tokens:    ix.TokensOf(r.Context(), owner, withMetadata),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
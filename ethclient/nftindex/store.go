@@ -0,0 +1,85 @@
+package nftindex
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Store 由 Transfer 事件构建的 owner -> tokens 表
+type Store struct {
+	lock sync.RWMutex
+	// tokenID(十进制字符串) -> 当前持有者
+	owners map[string]common.Address
+	// 持有者 -> tokenID 集合
+	tokens map[common.Address]map[string]*big.Int
+	// 下一个待处理的区块高度
+	next uint64
+}
+
+func NewStore() *Store {
+	return &Store{
+		owners: make(map[string]common.Address),
+		tokens: make(map[common.Address]map[string]*big.Int),
+	}
+}
+
+// Apply 按顺序应用一次转移，from 为零地址表示铸造，to 为零地址表示销毁
+func (s *Store) Apply(from, to common.Address, tokenID *big.Int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := tokenID.String()
+	if owner, ok := s.owners[key]; ok {
+		delete(s.tokens[owner], key)
+		if len(s.tokens[owner]) == 0 {
+			delete(s.tokens, owner)
+		}
+		delete(s.owners, key)
+	}
+	if to == (common.Address{}) {
+		return
+	}
+	s.owners[key] = to
+	if s.tokens[to] == nil {
+		s.tokens[to] = make(map[string]*big.Int)
+	}
+	s.tokens[to][key] = new(big.Int).Set(tokenID)
+}
+
+// OwnerOf 返回 token 当前持有者
+func (s *Store) OwnerOf(tokenID *big.Int) (common.Address, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	owner, ok := s.owners[tokenID.String()]
+	return owner, ok
+}
+
+// TokensOf 返回持有者的全部 tokenID，按从小到大排序
+func (s *Store) TokensOf(owner common.Address) []*big.Int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	ids := make([]*big.Int, 0, len(s.tokens[owner]))
+	for _, id := range s.tokens[owner] {
+		ids = append(ids, new(big.Int).Set(id))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+	return ids
+}
+
+// Next 下一个待处理的区块高度，之前的区块都已计入
+func (s *Store) Next() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.next
+}
+
+func (s *Store) setNext(block uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.next = block
+}
@@ -0,0 +1,265 @@
+package slots
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StorageReader *ethclient.Client 和 simulated 后端都满足
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// 动态数组默认最多读取的元素个数
+const DefaultLimit = 100
+
+// Inspector 按存储布局读取一个合约的状态
+type Inspector struct {
+	backend StorageReader
+	address common.Address
+	layout  *Layout
+	// 动态数组最多读取的元素个数
+	Limit int
+}
+
+func NewInspector(backend StorageReader, address common.Address, layout *Layout) *Inspector {
+	return &Inspector{backend: backend, address: address, layout: layout, Limit: DefaultLimit}
+}
+
+// Result 一个路径的读取结果
+type Result struct {
+	Path   string      `json:"path"`
+	Slot   common.Hash `json:"slot"`
+	Offset int         `json:"offset"`
+	Type   string      `json:"type"`
+	// mapping 不能遍历，只输出槽位
+	Value interface{} `json:"value,omitempty"`
+	// 动态数组、string、bytes 的长度
+	Length *uint64 `json:"length,omitempty"`
+}
+
+// location 一个值在存储中的位置
+type location struct {
+	slot   *big.Int
+	offset int
+	typ    *Type
+}
+
+// Read 读取路径对应的值，如 "version"、"items[0x01]"、"crossChainBids[0xab...].bidder"、"bids[2]"
+// block 为 nil 时读取最新区块
+func (i *Inspector) Read(ctx context.Context, path string, block *big.Int) (*Result, error) {
+	loc, err := i.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{
+		Path:   path,
+		Slot:   common.BigToHash(loc.slot),
+		Offset: loc.offset,
+		Type:   loc.typ.Label,
+	}
+	reader := &reader{inspector: i, ctx: ctx, block: block}
+	if result.Value, result.Length, err = reader.read(loc); err != nil {
+		return nil, fmt.Errorf("读取 %s 失败: %w", path, err)
+	}
+	return result, nil
+}
+
+// Dump 读取全部状态变量，mapping 只输出槽位
+func (i *Inspector) Dump(ctx context.Context, block *big.Int) ([]*Result, error) {
+	results := make([]*Result, 0, len(i.layout.Storage))
+	for _, v := range i.layout.Storage {
+		result, err := i.Read(ctx, v.Label, block)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Slot 读取一个槽位的原始 32 字节
+func (i *Inspector) Slot(ctx context.Context, slot common.Hash, block *big.Int) (hexutil.Bytes, error) {
+	return i.backend.StorageAt(ctx, i.address, slot, block)
+}
+
+// 路径中的一段: .member 或 [key]
+var segmentPattern = regexp.MustCompile(`^(?:\.([A-Za-z_$][A-Za-z0-9_$]*)|\[([^\]]*)\])`)
+
+// resolve 按路径逐段计算槽位
+func (i *Inspector) resolve(path string) (*location, error) {
+	name := path
+	if n := strings.IndexAny(path, ".["); n >= 0 {
+		name = path[:n]
+	}
+	v, err := i.layout.Var(name)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := i.varLocation(big.NewInt(0), v)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := path[len(name):]
+	for rest != "" {
+		m := segmentPattern.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, fmt.Errorf("无法解析路径 %s 中的 %s", path, rest)
+		}
+		rest = rest[len(m[0]):]
+		if m[1] != "" {
+			loc, err = i.member(loc, m[1])
+		} else {
+			loc, err = i.index(loc, strings.TrimSpace(m[2]))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return loc, nil
+}
+
+// varLocation 变量或结构体成员的位置，base 为所在结构体的起始槽
+func (i *Inspector) varLocation(base *big.Int, v *Var) (*location, error) {
+	slot, err := v.slot()
+	if err != nil {
+		return nil, err
+	}
+	typ, err := i.layout.Type(v.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &location{slot: new(big.Int).Add(base, slot), offset: v.Offset, typ: typ}, nil
+}
+
+// member 结构体成员
+func (i *Inspector) member(loc *location, name string) (*location, error) {
+	if loc.typ.Members == nil {
+		return nil, fmt.Errorf("%s 不是结构体，没有成员 %s", loc.typ.Label, name)
+	}
+	for _, member := range loc.typ.Members {
+		if member.Label == name {
+			return i.varLocation(loc.slot, &member)
+		}
+	}
+	return nil, fmt.Errorf("%s 没有成员 %s", loc.typ.Label, name)
+}
+
+// index mapping 的键或数组下标
+func (i *Inspector) index(loc *location, key string) (*location, error) {
+	switch {
+	case loc.typ.Encoding == encodingMapping:
+		keyType, err := i.layout.Type(loc.typ.Key)
+		if err != nil {
+			return nil, err
+		}
+		valueType, err := i.layout.Type(loc.typ.Value)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := encodeKey(keyType, key)
+		if err != nil {
+			return nil, err
+		}
+		// mapping 的值位于 keccak256(key . slot)，值类型的键填充为 32 字节，string/bytes 的键不填充
+		slot := crypto.Keccak256(encoded, common.BigToHash(loc.slot).Bytes())
+		return &location{slot: new(big.Int).SetBytes(slot), typ: valueType}, nil
+
+	case loc.typ.Base != "":
+		n, err := strconv.ParseUint(key, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("无效的数组下标 %s", key)
+		}
+		elem, err := i.layout.Type(loc.typ.Base)
+		if err != nil {
+			return nil, err
+		}
+		base := loc.slot
+		if loc.typ.Encoding == encodingDynamicArray {
+			// 动态数组的槽位保存长度，元素从 keccak256(slot) 开始
+			base = new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(loc.slot).Bytes()))
+		} else if length, ok := staticLength(loc.typ); ok && n >= length {
+			return nil, fmt.Errorf("下标 %d 超出 %s 的长度", n, loc.typ.Label)
+		}
+		return elementLocation(base, elem, n), nil
+	}
+	return nil, fmt.Errorf("%s 不能使用下标", loc.typ.Label)
+}
+
+// elementLocation 数组第 n 个元素的位置，小于 32 字节的元素打包存放
+func elementLocation(base *big.Int, elem *Type, n uint64) *location {
+	size := elem.Size()
+	index := new(big.Int).SetUint64(n)
+	if size > 0 && size < 32 {
+		perSlot := uint64(32 / size)
+		slot := new(big.Int).Add(base, new(big.Int).SetUint64(n/perSlot))
+		return &location{slot: slot, offset: int(n%perSlot) * size, typ: elem}
+	}
+	slots := big.NewInt(int64((size + 31) / 32))
+	return &location{slot: new(big.Int).Add(base, index.Mul(index, slots)), typ: elem}
+}
+
+// 定长数组的标签形如 uint256[3]
+var staticArrayPattern = regexp.MustCompile(`\[(\d+)\]$`)
+
+func staticLength(t *Type) (uint64, bool) {
+	m := staticArrayPattern.FindStringSubmatch(t.Label)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(m[1], 10, 64)
+	return n, err == nil
+}
+
+// encodeKey 按键类型编码 mapping 的键
+func encodeKey(t *Type, key string) ([]byte, error) {
+	label := t.Label
+	switch {
+	case label == "string":
+		return []byte(strings.Trim(key, `"'`)), nil
+	case label == "bytes":
+		return hexutil.Decode(key)
+	case label == "address" || strings.HasPrefix(label, "contract "):
+		if !common.IsHexAddress(key) {
+			return nil, fmt.Errorf("无效的地址键 %s", key)
+		}
+		return common.LeftPadBytes(common.HexToAddress(key).Bytes(), 32), nil
+	case label == "bool":
+		switch key {
+		case "true":
+			return common.LeftPadBytes([]byte{1}, 32), nil
+		case "false":
+			return make([]byte, 32), nil
+		}
+		return nil, fmt.Errorf("无效的 bool 键 %s", key)
+	case strings.HasPrefix(label, "bytes"):
+		b, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, fmt.Errorf("无效的 %s 键 %s: %w", label, key, err)
+		}
+		if len(b) > t.Size() {
+			return nil, fmt.Errorf("键 %s 超过 %s 的长度", key, label)
+		}
+		return common.RightPadBytes(b, 32), nil
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "int"), strings.HasPrefix(label, "enum "):
+		n, ok := math.ParseBig256(key)
+		if !ok {
+			return nil, fmt.Errorf("无效的整数键 %s", key)
+		}
+		if n.Sign() < 0 && !strings.HasPrefix(label, "int") {
+			return nil, fmt.Errorf("%s 的键不能为负数", label)
+		}
+		return math.U256Bytes(n), nil
+	}
+	return nil, fmt.Errorf("不支持 %s 类型的键", label)
+}
@@ -0,0 +1,186 @@
+package slots
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ethclient/devchain"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Layout.sol 的字节码和存储布局，在 slots 目录运行 go generate 重新生成
+//go:generate solc --bin --storage-layout --overwrite -o testdata testdata/Layout.sol
+
+const storeContracts = "../../nft_market-main/contracts/"
+
+// deploy 在内存链上部署合约，返回读取它的 Inspector
+func deploy(t *testing.T, chain *devchain.Chain, abiPath, binPath, layoutPath string, args ...interface{}) (*Inspector, *bind.BoundContract) {
+	t.Helper()
+	parsed := &abi.ABI{}
+	if abiPath != "" {
+		f, err := os.Open(abiPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if *parsed, err = abi.JSON(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	code, err := devchain.ReadBin(binPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, _, err := chain.Deploy(context.Background(), chain.Accounts[0], parsed, code, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	layout, err := LoadLayout(layoutPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := bind.NewBoundContract(address, *parsed, chain.Client, chain.Client, chain.Client)
+	return NewInspector(chain.Client, address, layout), contract
+}
+
+func keccakSlot(parts ...[]byte) common.Hash {
+	return common.BytesToHash(crypto.Keccak256(parts...))
+}
+
+func TestStore(t *testing.T) {
+	chain, err := devchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	ctx := context.Background()
+
+	// 31 字节以内的 string 和长度放在同一个槽，更长的放在 keccak256(slot) 开始的槽
+	for _, version := range []string{"", "1.0", strings.Repeat("v", 31), strings.Repeat("v", 32), strings.Repeat("版本", 20)} {
+		inspector, store := deploy(t, chain, storeContracts+"Store_sol_Store.abi", storeContracts+"Store_sol_Store.bin", "Store.layout.json", version)
+		result, err := inspector.Read(ctx, "version", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Value != version || result.Length == nil || *result.Length != uint64(len(version)) {
+			t.Errorf("version = %q (%v), want %q", result.Value, result.Length, version)
+		}
+		raw, err := inspector.Slot(ctx, common.Hash{}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if long := len(version) > 31; long != (raw[31]&1 == 1) {
+			t.Errorf("长度 %d 的 version 槽 0 = %x", len(version), raw)
+		}
+
+		// 路径中的 0x01 和 solidity 的 bytes32 一样右侧补零
+		key := common.Hash{0: 1}
+		value := common.HexToHash("0xff00000000000000000000000000000000000000000000000000000000000001")
+		tx, err := store.Transact(chain.Accounts[0], "setItem", key, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Commit()
+		if _, err := bind.WaitMined(ctx, chain.Client, tx); err != nil {
+			t.Fatal(err)
+		}
+		// mapping 的值位于 keccak256(key . slot)，items 在槽 1
+		result, err = inspector.Read(ctx, "items[0x01]", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantSlot := keccakSlot(key.Bytes(), common.BigToHash(big.NewInt(1)).Bytes())
+		if result.Slot != wantSlot || result.Value != value.Hex() {
+			t.Errorf("items[0x01] = %s at %s, want %s at %s", result.Value, result.Slot.Hex(), value.Hex(), wantSlot.Hex())
+		}
+	}
+}
+
+func TestLayout(t *testing.T) {
+	chain, err := devchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	ctx := context.Background()
+	inspector, _ := deploy(t, chain, "", "testdata/Layout.bin", "testdata/Layout_storage.json")
+	deployer := chain.Accounts[0].From.Hex()
+
+	numbers := keccakSlot(common.BigToHash(big.NewInt(2)).Bytes())
+	// Bid 的三个成员打包在一个槽里
+	bid1 := common.BigToHash(new(big.Int).Add(keccakSlot(common.BigToHash(big.NewInt(6)).Bytes()).Big(), big.NewInt(1)))
+	tests := []struct {
+		path   string
+		want   interface{}
+		length int
+		slot   common.Hash
+	}{
+		// 有符号整数和其他变量打包在同一个槽，按各自的宽度做符号扩展
+		{"small", "-1", -1, common.Hash{}},
+		{"packed", "-2", -1, common.Hash{}},
+		{"unsigned", "65535", -1, common.Hash{}},
+		{"wide", "-3", -1, common.BigToHash(big.NewInt(1))},
+		// 动态数组的槽保存长度，元素从 keccak256(slot) 开始
+		{"numbers", []interface{}{"1", "2", "3"}, 3, common.BigToHash(big.NewInt(2))},
+		{"numbers[2]", "3", -1, common.BigToHash(new(big.Int).Add(numbers.Big(), big.NewInt(2)))},
+		{"deltas", []interface{}{"-1", "2", "-32768"}, 3, common.BigToHash(big.NewInt(3))},
+		{"deltas[2]", "-32768", -1, keccakSlot(common.BigToHash(big.NewInt(3)).Bytes())},
+		{"blob", "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f2021222324252627", 40, common.BigToHash(big.NewInt(4))},
+		{"note", "0xc0ffee", 3, common.BigToHash(big.NewInt(5))},
+		{"bids[1].amount", "200", -1, bid1},
+		{"bids[1].winner", true, -1, bid1},
+		{"fixedItems", []interface{}{"7", "8", "9"}, -1, common.BigToHash(big.NewInt(7))},
+		{"bidOf[" + deployer + "]", map[string]interface{}{"bidder": deployer, "amount": "300", "winner": true}, -1,
+			keccakSlot(common.LeftPadBytes(chain.Accounts[0].From.Bytes(), 32), common.BigToHash(big.NewInt(8)).Bytes())},
+		// string 的键不填充
+		{`scores["alice"]`, "-7", -1, keccakSlot([]byte("alice"), common.BigToHash(big.NewInt(9)).Bytes())},
+	}
+	for _, tt := range tests {
+		result, err := inspector.Read(ctx, tt.path, nil)
+		if err != nil {
+			t.Errorf("Read(%s) error: %v", tt.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(result.Value, tt.want) || result.Slot != tt.slot {
+			t.Errorf("Read(%s) = %v at %s, want %v at %s", tt.path, result.Value, result.Slot.Hex(), tt.want, tt.slot.Hex())
+		}
+		length := -1
+		if result.Length != nil {
+			length = int(*result.Length)
+		}
+		if length != tt.length {
+			t.Errorf("Read(%s) length = %v, want %d", tt.path, result.Length, tt.length)
+		}
+	}
+
+	bids, err := inspector.Read(ctx, "bids", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []interface{}{
+		map[string]interface{}{"bidder": deployer, "amount": "100", "winner": false},
+		map[string]interface{}{"bidder": inspector.address.Hex(), "amount": "200", "winner": true},
+	}
+	if !reflect.DeepEqual(bids.Value, want) {
+		t.Errorf("bids = %v, want %v", bids.Value, want)
+	}
+
+	// Limit 限制读取的元素个数，长度仍是实际长度
+	inspector.Limit = 2
+	if result, err := inspector.Read(ctx, "numbers", nil); err != nil || len(result.Value.([]interface{})) != 2 || *result.Length != 3 {
+		t.Errorf("Limit = 2 时 numbers = %v (%v), %v", result.Value, result.Length, err)
+	}
+
+	for _, path := range []string{"fixedItems[3]", "small[0]", "numbers.length", "bids[0].price", "bidOf[0x1234]", "missing"} {
+		if _, err := inspector.Read(ctx, path, nil); err == nil {
+			t.Errorf("Read(%s) 应该失败", path)
+		}
+	}
+}
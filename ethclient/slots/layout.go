@@ -0,0 +1,110 @@
+package slots
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+)
+
+/**
+合约存储布局查看
+    按 solc --storage-layout 输出的布局 JSON，用 StorageAt 直接读取原始存储，不需要 getter
+    支持 items[key]、crossChainBids[id].bidder、arr[2] 这样的路径，mapping 和动态数组的槽位按 keccak256 计算
+    可以指定历史区块，查看某个区块时的状态
+*/
+
+// Layout solc --storage-layout 的输出
+type Layout struct {
+	Storage []Var            `json:"storage"`
+	Types   map[string]*Type `json:"types"`
+}
+
+// Var 状态变量或结构体成员
+type Var struct {
+	Label string `json:"label"`
+	// 在槽内从低位算起的字节偏移，多个小变量可能打包在同一个槽
+	Offset int    `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// Type 布局中的类型
+type Type struct {
+	// inplace、mapping、dynamic_array 或 bytes
+	Encoding      string `json:"encoding"`
+	Label         string `json:"label"`
+	NumberOfBytes string `json:"numberOfBytes"`
+	// mapping 的键和值类型
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+	// 数组的元素类型
+	Base string `json:"base,omitempty"`
+	// 结构体成员，Slot 相对结构体起始槽
+	Members []Var `json:"members,omitempty"`
+}
+
+const (
+	encodingInplace      = "inplace"
+	encodingMapping      = "mapping"
+	encodingDynamicArray = "dynamic_array"
+	encodingBytes        = "bytes"
+)
+
+// LoadLayout 读取布局 JSON，既可以是 storageLayout 对象本身，也可以是包含 storageLayout 字段的 solc 输出
+func LoadLayout(path string) (*Layout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var wrapper struct {
+		StorageLayout *Layout `json:"storageLayout"`
+		Layout
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, fmt.Errorf("解析存储布局 %s 失败: %w", path, err)
+	}
+	layout := wrapper.StorageLayout
+	if layout == nil {
+		layout = &wrapper.Layout
+	}
+	if len(layout.Storage) == 0 {
+		return nil, fmt.Errorf("%s 中没有状态变量", path)
+	}
+	return layout, nil
+}
+
+// Var 按名字查找状态变量
+func (l *Layout) Var(label string) (*Var, error) {
+	for i := range l.Storage {
+		if l.Storage[i].Label == label {
+			return &l.Storage[i], nil
+		}
+	}
+	return nil, fmt.Errorf("没有状态变量 %s", label)
+}
+
+// Type 按类型 ID 查找类型
+func (l *Layout) Type(id string) (*Type, error) {
+	t, ok := l.Types[id]
+	if !ok {
+		return nil, fmt.Errorf("布局中没有类型 %s", id)
+	}
+	return t, nil
+}
+
+// Size 类型占用的字节数
+func (t *Type) Size() int {
+	n, _ := strconv.Atoi(t.NumberOfBytes)
+	return n
+}
+
+// slot 解析十进制的槽位
+func (v *Var) slot() (*big.Int, error) {
+	slot, ok := new(big.Int).SetString(v.Slot, 10)
+	if !ok {
+		return nil, fmt.Errorf("%s 的槽位无效: %s", v.Label, v.Slot)
+	}
+	return slot, nil
+}
@@ -0,0 +1,174 @@
+package slots
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// reader 在同一个区块上读取值，同一个槽只读取一次
+type reader struct {
+	inspector *Inspector
+	ctx       context.Context
+	block     *big.Int
+	words     map[common.Hash][]byte
+}
+
+func (r *reader) word(slot *big.Int) ([]byte, error) {
+	key := common.BigToHash(slot)
+	if word, ok := r.words[key]; ok {
+		return word, nil
+	}
+	word, err := r.inspector.backend.StorageAt(r.ctx, r.inspector.address, key, r.block)
+	if err != nil {
+		return nil, err
+	}
+	word = common.LeftPadBytes(word, 32)
+	if r.words == nil {
+		r.words = make(map[common.Hash][]byte)
+	}
+	r.words[key] = word
+	return word, nil
+}
+
+// read 按类型读取位置上的值，动态数组和 string/bytes 同时返回长度
+func (r *reader) read(loc *location) (interface{}, *uint64, error) {
+	t := loc.typ
+	switch {
+	case t.Encoding == encodingMapping:
+		return nil, nil, nil
+
+	case t.Encoding == encodingBytes:
+		return r.readBytes(loc)
+
+	case t.Encoding == encodingDynamicArray:
+		word, err := r.word(loc.slot)
+		if err != nil {
+			return nil, nil, err
+		}
+		length := new(big.Int).SetBytes(word)
+		if !length.IsUint64() {
+			return nil, nil, fmt.Errorf("%s 的长度无效", t.Label)
+		}
+		n := length.Uint64()
+		elem, err := r.inspector.layout.Type(t.Base)
+		if err != nil {
+			return nil, nil, err
+		}
+		base := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(loc.slot).Bytes()))
+		items, err := r.readArray(base, elem, min(n, uint64(r.inspector.Limit)))
+		return items, &n, err
+
+	case t.Base != "":
+		n, _ := staticLength(t)
+		elem, err := r.inspector.layout.Type(t.Base)
+		if err != nil {
+			return nil, nil, err
+		}
+		items, err := r.readArray(loc.slot, elem, n)
+		return items, nil, err
+
+	case t.Members != nil:
+		fields := make(map[string]interface{}, len(t.Members))
+		for _, member := range t.Members {
+			memberLoc, err := r.inspector.varLocation(loc.slot, &member)
+			if err != nil {
+				return nil, nil, err
+			}
+			value, _, err := r.read(memberLoc)
+			if err != nil {
+				return nil, nil, err
+			}
+			fields[member.Label] = value
+		}
+		return fields, nil, nil
+	}
+
+	word, err := r.word(loc.slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	size := t.Size()
+	if size <= 0 || loc.offset+size > 32 {
+		return nil, nil, fmt.Errorf("%s 的大小或偏移无效", t.Label)
+	}
+	// 值在槽内右对齐，offset 从低位算起
+	return decodeValue(t, word[32-loc.offset-size:32-loc.offset]), nil, nil
+}
+
+func (r *reader) readArray(base *big.Int, elem *Type, n uint64) ([]interface{}, error) {
+	items := make([]interface{}, 0, n)
+	for i := uint64(0); i < n; i++ {
+		value, _, err := r.read(elementLocation(base, elem, i))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, value)
+	}
+	return items, nil
+}
+
+// readBytes string 和 bytes 的存储:
+// 短于 32 字节时数据和 length*2 放在同一个槽，最低位为 0；
+// 否则槽中保存 length*2+1，数据从 keccak256(slot) 开始连续存放
+func (r *reader) readBytes(loc *location) (interface{}, *uint64, error) {
+	word, err := r.word(loc.slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	var data []byte
+	if word[31]&1 == 0 {
+		n := uint64(word[31] / 2)
+		if n > 31 {
+			return nil, nil, fmt.Errorf("%s 的短编码长度无效", loc.typ.Label)
+		}
+		data = word[:n]
+	} else {
+		length := new(big.Int).Rsh(new(big.Int).SetBytes(word), 1)
+		if !length.IsUint64() || length.Uint64() > 1<<20 {
+			return nil, nil, fmt.Errorf("%s 的长度无效", loc.typ.Label)
+		}
+		n := length.Uint64()
+		base := new(big.Int).SetBytes(crypto.Keccak256(common.BigToHash(loc.slot).Bytes()))
+		for i := uint64(0); uint64(len(data)) < n; i++ {
+			chunk, err := r.word(new(big.Int).Add(base, new(big.Int).SetUint64(i)))
+			if err != nil {
+				return nil, nil, err
+			}
+			data = append(data, chunk...)
+		}
+		data = data[:n]
+	}
+	n := uint64(len(data))
+	if loc.typ.Label == "string" {
+		return string(data), &n, nil
+	}
+	return hexutil.Encode(data), &n, nil
+}
+
+// decodeValue 解码值类型，整数输出为十进制字符串
+func decodeValue(t *Type, b []byte) interface{} {
+	label := t.Label
+	switch {
+	case label == "address" || strings.HasPrefix(label, "contract "):
+		return common.BytesToAddress(b).Hex()
+	case label == "bool":
+		return b[len(b)-1] != 0
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "enum "):
+		return new(big.Int).SetBytes(b).String()
+	case strings.HasPrefix(label, "int"):
+		n := new(big.Int).SetBytes(b)
+		// 最高位为 1 时是负数，按补码还原
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+		}
+		return n.String()
+	}
+	// bytesN、函数类型等按原始字节输出
+	return hexutil.Encode(b)
+}
@@ -0,0 +1,36 @@
+package slots
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDecodeValue(t *testing.T) {
+	tests := []struct {
+		label string
+		b     []byte
+		want  interface{}
+	}{
+		{"int8", []byte{0x7f}, "127"},
+		{"int8", []byte{0x80}, "-128"},
+		{"int8", []byte{0xff}, "-1"},
+		{"uint8", []byte{0xff}, "255"},
+		{"int16", []byte{0x80, 0x00}, "-32768"},
+		{"int16", []byte{0x00, 0x80}, "128"},
+		{"int256", common.Hex2Bytes("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"), "-1"},
+		{"int256", common.Hex2Bytes("8000000000000000000000000000000000000000000000000000000000000000"),
+			"-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{"enum Status", []byte{0x02}, "2"},
+		{"bool", []byte{0x01}, true},
+		{"bool", []byte{0x00}, false},
+		{"address", common.Hex2Bytes("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"contract Auction", common.Hex2Bytes("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"bytes4", []byte{0xc0, 0xff, 0xee, 0x00}, "0xc0ffee00"},
+	}
+	for _, tt := range tests {
+		if got := decodeValue(&Type{Label: tt.label}, tt.b); got != tt.want {
+			t.Errorf("decodeValue(%s, %x) = %v, want %v", tt.label, tt.b, got, tt.want)
+		}
+	}
+}
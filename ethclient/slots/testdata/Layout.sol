@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+// slots 测试用的合约，覆盖打包的有符号整数、动态数组、长短 bytes、结构体和 mapping
+contract Layout {
+    struct Bid {
+        address bidder;
+        uint64 amount;
+        bool winner;
+    }
+
+    int8 public small = -1;
+    int128 public packed = -2;
+    uint16 public unsigned = 65535;
+    int256 public wide = -3;
+    uint256[] public numbers;
+    int16[] public deltas;
+    bytes public blob;
+    bytes public note;
+    Bid[] public bids;
+    uint8[3] public fixedItems;
+    mapping(address => Bid) public bidOf;
+    mapping(string => int64) public scores;
+
+    constructor() {
+        numbers = [1, 2, 3];
+        deltas = [int16(-1), 2, -32768];
+        blob = hex"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f2021222324252627";
+        note = hex"c0ffee";
+        bids.push(Bid(msg.sender, 100, false));
+        bids.push(Bid(address(this), 200, true));
+        fixedItems = [7, 8, 9];
+        bidOf[msg.sender] = Bid(msg.sender, 300, true);
+        scores["alice"] = -7;
+    }
+}
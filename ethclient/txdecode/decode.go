@@ -0,0 +1,213 @@
+package txdecode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"ethclient/abiargs"
+	"ethclient/dyncall"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+/**
+交易 calldata 和 revert 原因解码
+    calldata 按已注册 ABI 的方法选择器解码出方法名和参数
+    revert 数据解码 Error(string)、Panic(uint256) 和已注册 ABI 中的自定义错误
+    按交易哈希解码时，失败的交易会在原区块上重放以取得 revert 数据
+*/
+
+var (
+	// Error(string) 的选择器 0x08c379a0
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// Panic(uint256) 的选择器 0x4e487b71
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons solidity 0.8 Panic(uint256) 错误码的含义
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assert 失败",
+	0x11: "算术运算溢出或下溢",
+	0x12: "除以零或对零取模",
+	0x21: "转换为枚举时值越界",
+	0x22: "存储中的字节数组编码错误",
+	0x31: "对空数组调用 pop",
+	0x32: "数组下标越界",
+	0x41: "内存分配过大",
+	0x51: "调用未初始化的内部函数指针",
+}
+
+// Call 解码后的方法调用
+type Call struct {
+	Contract  string                 `json:"contract"`
+	Method    string                 `json:"method"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+// RevertKind revert 数据的类型
+type RevertKind string
+
+const (
+	// require(cond, "reason") 或 revert("reason")
+	RevertError RevertKind = "Error"
+	// assert、溢出、越界等
+	RevertPanic RevertKind = "Panic"
+	// revert CustomError(...)
+	RevertCustom RevertKind = "custom"
+	// 没有数据，如 require(cond) 或 revert()
+	RevertEmpty RevertKind = "empty"
+	// 不认识的选择器
+	RevertUnknown RevertKind = "unknown"
+)
+
+// Revert 解码后的 revert 原因
+type Revert struct {
+	Kind   RevertKind `json:"kind"`
+	Reason string     `json:"reason,omitempty"`
+	// Panic 的错误码
+	Code *uint64 `json:"code,omitempty"`
+	// 自定义错误所属的合约、签名和参数
+	Contract  string                 `json:"contract,omitempty"`
+	Signature string                 `json:"signature,omitempty"`
+	Args      map[string]interface{} `json:"args,omitempty"`
+	Data      hexutil.Bytes          `json:"data,omitempty"`
+}
+
+func (r *Revert) String() string {
+	switch r.Kind {
+	case RevertError:
+		return fmt.Sprintf("Error(%q)", r.Reason)
+	case RevertPanic:
+		return fmt.Sprintf("Panic(0x%02x): %s", *r.Code, r.Reason)
+	case RevertCustom:
+		return fmt.Sprintf("%s.%s %v", r.Contract, r.Signature, r.Args)
+	case RevertEmpty:
+		return "revert 没有返回原因"
+	}
+	return "未知的 revert 数据 " + r.Data.String()
+}
+
+// DecodeCalldata 按方法选择器解码 calldata，选择器冲突时返回第一个能解码的方法
+func (r *Registry) DecodeCalldata(data []byte) (*Call, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata 不足 4 字节")
+	}
+	var id [4]byte
+	copy(id[:], data[:4])
+	candidates := r.methods[id]
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("没有选择器为 %s 的已知方法", hexutil.Encode(id[:]))
+	}
+	var lastErr error
+	for _, candidate := range candidates {
+		values, err := candidate.method.Inputs.Unpack(data[4:])
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return &Call{
+			Contract:  candidate.contract,
+			Method:    candidate.method.RawName,
+			Signature: candidate.method.Sig,
+			Args:      abiargs.FormatAll(candidate.method.Inputs, values),
+		}, nil
+	}
+	return nil, fmt.Errorf("按 %s 解码参数失败: %w", candidates[0].method.Sig, lastErr)
+}
+
+// Log 解码后的日志
+type Log struct {
+	Contract string `json:"contract"`
+	*dyncall.Event
+}
+
+// DecodeLog 按 topic0 解码日志，没有已知事件时返回 nil
+// 签名相同但 indexed 参数不同的事件(如 ERC-20 和 ERC-721 的 Transfer)按 topic 数量区分
+func (r *Registry) DecodeLog(l types.Log) (*Log, error) {
+	if len(l.Topics) == 0 {
+		return nil, nil
+	}
+	var lastErr error
+	for _, candidate := range r.events[l.Topics[0]] {
+		event, err := dyncall.NewContract(l.Address, candidate.abi, nil).DecodeLog(l)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if event != nil {
+			return &Log{Contract: candidate.contract, Event: event}, nil
+		}
+	}
+	return nil, lastErr
+}
+
+// DecodeRevert 解码 revert 返回的数据
+func (r *Registry) DecodeRevert(data []byte) *Revert {
+	if len(data) == 0 {
+		return &Revert{Kind: RevertEmpty}
+	}
+	if len(data) < 4 {
+		return &Revert{Kind: RevertUnknown, Data: data}
+	}
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			return &Revert{Kind: RevertError, Reason: reason, Data: data}
+		}
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) == 4+32 {
+			code := new(big.Int).SetBytes(data[4:])
+			if code.IsUint64() {
+				c := code.Uint64()
+				reason, ok := panicReasons[c]
+				if !ok {
+					reason = "未知的 panic 错误码"
+				}
+				return &Revert{Kind: RevertPanic, Reason: reason, Code: &c, Data: data}
+			}
+		}
+	default:
+		var id [4]byte
+		copy(id[:], data[:4])
+		for _, candidate := range r.errors[id] {
+			values, err := candidate.err.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			return &Revert{
+				Kind:      RevertCustom,
+				Reason:    candidate.err.Name,
+				Contract:  candidate.contract,
+				Signature: candidate.err.Sig,
+				Args:      abiargs.FormatAll(candidate.err.Inputs, values),
+				Data:      data,
+			}
+		}
+	}
+	return &Revert{Kind: RevertUnknown, Data: data}
+}
+
+// RevertData 从 eth_call / eth_estimateGas 返回的错误中取出 revert 数据
+// 节点没有返回数据(如 require 没有原因)时 ok 为 true、data 为空；不是 revert 错误时 ok 为 false
+func RevertData(err error) (data []byte, ok bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if s, isString := dataErr.ErrorData().(string); isString {
+			if b, decodeErr := hexutil.Decode(s); decodeErr == nil {
+				return b, true
+			}
+		}
+	}
+	if err != nil && strings.Contains(err.Error(), "execution reverted") {
+		return nil, true
+	}
+	return nil, false
+}
@@ -0,0 +1,153 @@
+package txdecode
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"ethclient/dyncall"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// bindings genCode 中所有绑定的 ABI，按合约名注册
+var bindings = map[string]*bind.MetaData{
+	"Auction":          genCode.AuctionMetaData,
+	"AuctionFactory":   genCode.AuctionFactoryMetaData,
+	"NftToken":         genCode.NftTokenMetaData,
+	"IERC20":           genCode.IERC20MetaData,
+	"Erc201":           genCode.Erc201MetaData,
+	"BeggingContract":  genCode.BeggingContractMetaData,
+	"Voting":           genCode.VotingMetaData,
+	"Count":            genCode.CountMetaData,
+	"BinarySearch":     genCode.BinarySearchMetaData,
+	"IntToRoman":       genCode.IntToRomanMetaData,
+	"MergeSortedArray": genCode.MergeSortedArrayMetaData,
+	"ReserseString":    genCode.ReserseStringMetaData,
+	"RomanToInt":       genCode.RomanToIntMetaData,
+}
+
+// Binding 按合约名查找 genCode 中的绑定
+func Binding(name string) (*bind.MetaData, bool) {
+	meta, ok := bindings[name]
+	return meta, ok
+}
+
+// entry 注册的方法、自定义错误或事件及其所属合约
+type entry struct {
+	contract string
+	method   *abi.Method
+	err      *abi.Error
+	// 事件所属合约的完整 ABI，解码日志时使用
+	abi *abi.ABI
+}
+
+// Registry 已知的 ABI，按 4 字节选择器索引方法和自定义错误，按 topic0 索引事件
+// 不同合约可能有相同选择器(如 OwnableUnauthorizedAccount)，解码时依次尝试
+type Registry struct {
+	methods map[[4]byte][]entry
+	errors  map[[4]byte][]entry
+	events  map[common.Hash][]entry
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		methods: make(map[[4]byte][]entry),
+		errors:  make(map[[4]byte][]entry),
+		events:  make(map[common.Hash][]entry),
+	}
+}
+
+// DefaultRegistry 注册 genCode 中的全部绑定
+func DefaultRegistry() (*Registry, error) {
+	r := NewRegistry()
+	if err := r.AddBindings(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// AddBindings 注册 genCode 中的全部绑定
+func (r *Registry) AddBindings() error {
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	// 固定注册顺序，选择器冲突时解码结果稳定
+	sort.Strings(names)
+	for _, name := range names {
+		parsed, err := bindings[name].GetAbi()
+		if err != nil {
+			return fmt.Errorf("解析 %s 绑定的 ABI 失败: %w", name, err)
+		}
+		r.Add(name, parsed)
+	}
+	return nil
+}
+
+// AddDir 注册目录下(含子目录)所有 .abi 文件，合约名取文件名中最后一个 _sol_ 之后的部分
+func (r *Registry) AddDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".abi" {
+			return nil
+		}
+		parsed, err := dyncall.LoadABI(path)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(d.Name(), ".abi")
+		if i := strings.LastIndex(name, "_sol_"); i >= 0 {
+			name = name[i+len("_sol_"):]
+		}
+		r.Add(name, parsed)
+		return nil
+	})
+}
+
+// Add 注册一个合约的方法、自定义错误和事件，同一合约同一选择器只注册一次
+func (r *Registry) Add(contract string, parsed *abi.ABI) {
+	for _, method := range parsed.Methods {
+		method := method
+		var id [4]byte
+		copy(id[:], method.ID)
+		if !r.has(r.methods[id], contract) {
+			r.methods[id] = append(r.methods[id], entry{contract: contract, method: &method})
+		}
+	}
+	for _, e := range parsed.Errors {
+		e := e
+		var id [4]byte
+		copy(id[:], e.ID[:4])
+		if !r.has(r.errors[id], contract) {
+			r.errors[id] = append(r.errors[id], entry{contract: contract, err: &e})
+		}
+	}
+	for _, event := range parsed.Events {
+		if event.Anonymous || r.has(r.events[event.ID], contract) {
+			continue
+		}
+		r.events[event.ID] = append(r.events[event.ID], entry{contract: contract, abi: parsed})
+	}
+}
+
+func (r *Registry) has(entries []entry, contract string) bool {
+	for _, e := range entries {
+		if e.contract == contract {
+			return true
+		}
+	}
+	return false
+}
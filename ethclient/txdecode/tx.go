@@ -0,0 +1,113 @@
+package txdecode
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ChainReader 按交易哈希解码需要的链上访问能力，*ethclient.Client 满足
+type ChainReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// TxReport 一笔交易的解码结果
+type TxReport struct {
+	Hash    common.Hash     `json:"hash"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Value   *big.Int        `json:"value"`
+	Pending bool            `json:"pending"`
+	// 已上链时的执行结果
+	Status  *uint64 `json:"status,omitempty"`
+	Block   uint64  `json:"block,omitempty"`
+	GasUsed uint64  `json:"gasUsed,omitempty"`
+	Call    *Call   `json:"call,omitempty"`
+	// calldata 无法解码的原因
+	CallError string  `json:"callError,omitempty"`
+	Revert    *Revert `json:"revert,omitempty"`
+	// 重放的说明，如重放没有失败
+	ReplayNote string `json:"replayNote,omitempty"`
+}
+
+// DecodeTx 解码交易的 calldata；交易失败时在上一个区块的状态上重放，取得 revert 数据
+// 重放不包含同一区块中排在前面的交易，状态依赖这些交易时重放结果可能和实际不同
+func (r *Registry) DecodeTx(ctx context.Context, reader ChainReader, hash common.Hash) (*TxReport, error) {
+	tx, pending, err := reader.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("查询交易 %s 失败: %w", hash.Hex(), err)
+	}
+	chainID, err := reader.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	report := &TxReport{
+		Hash:    hash,
+		From:    from,
+		To:      tx.To(),
+		Value:   tx.Value(),
+		Pending: pending,
+	}
+	if tx.To() != nil {
+		if call, err := r.DecodeCalldata(tx.Data()); err != nil {
+			report.CallError = err.Error()
+		} else {
+			report.Call = call
+		}
+	}
+	if pending {
+		return report, nil
+	}
+
+	receipt, err := reader.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("查询交易 %s 收据失败: %w", hash.Hex(), err)
+	}
+	report.Status = &receipt.Status
+	report.Block = receipt.BlockNumber.Uint64()
+	report.GasUsed = receipt.GasUsed
+	if receipt.Status == types.ReceiptStatusSuccessful || tx.To() == nil {
+		return report, nil
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err = reader.CallContract(ctx, msg, parent)
+	if err == nil {
+		report.ReplayNote = "在上一个区块的状态上重放没有失败，失败可能依赖同一区块中的其他交易"
+		return report, nil
+	}
+	if data, ok := RevertData(err); ok {
+		report.Revert = r.DecodeRevert(data)
+	} else {
+		report.ReplayNote = "重放失败: " + err.Error()
+	}
+	return report, nil
+}
+
+// ExplainError 解码 abigen 绑定、eth_call 或 eth_estimateGas 返回的错误中的 revert 原因
+// 如 Auction.PlaceBidETH 估算 gas 失败时返回的错误，不是 revert 错误时 ok 为 false
+func (r *Registry) ExplainError(err error) (revert *Revert, ok bool) {
+	data, ok := RevertData(err)
+	if !ok {
+		return nil, false
+	}
+	return r.DecodeRevert(data), true
+}
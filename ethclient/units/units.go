@@ -0,0 +1,171 @@
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+/**
+金额单位换算
+    "1.5 ether"、"20 gwei"、"12.34"(指定小数位数) 精确解析为最小单位的 *big.Int，不经过浮点数
+    把最小单位的金额格式化为带单位的字符串，如 1.5 ether、20 gwei、12.34 USDC
+*/
+
+// Unit 以 10 的幂表示的 ETH 单位，值为相对 wei 的小数位数
+type Unit int
+
+const (
+	Wei    Unit = 0
+	Kwei   Unit = 3
+	Mwei   Unit = 6
+	Gwei   Unit = 9
+	Szabo  Unit = 12
+	Finney Unit = 15
+	Ether  Unit = 18
+)
+
+// unitNames 解析时接受的单位名(小写)
+var unitNames = map[string]Unit{
+	"wei":    Wei,
+	"kwei":   Kwei,
+	"mwei":   Mwei,
+	"gwei":   Gwei,
+	"szabo":  Szabo,
+	"finney": Finney,
+	"ether":  Ether,
+	"eth":    Ether,
+}
+
+func (u Unit) String() string {
+	switch u {
+	case Wei:
+		return "wei"
+	case Kwei:
+		return "kwei"
+	case Mwei:
+		return "mwei"
+	case Gwei:
+		return "gwei"
+	case Szabo:
+		return "szabo"
+	case Finney:
+		return "finney"
+	case Ether:
+		return "ether"
+	}
+	return fmt.Sprintf("1e%d wei", int(u))
+}
+
+// Value 1 个该单位等于多少 wei
+func (u Unit) Value() *big.Int {
+	return pow10(int(u))
+}
+
+// ParseUnits 把十进制数按 decimals 位小数解析为最小单位，如 ParseUnits("12.34", 6) = 12340000
+// 小数位数超过 decimals 时报错而不是舍入
+func ParseUnits(s string, decimals int) (*big.Int, error) {
+	value := strings.TrimSpace(s)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+	whole, frac, _ := strings.Cut(value, ".")
+	// 允许 "1."、".5"，不允许只有小数点
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return nil, fmt.Errorf("无效的金额: %q", s)
+	}
+	// 超出精度的部分必须全为 0
+	if len(frac) > decimals {
+		if strings.Trim(frac[decimals:], "0") != "" {
+			return nil, fmt.Errorf("金额 %q 超过 %d 位小数", s, decimals)
+		}
+		frac = frac[:decimals]
+	}
+	frac += strings.Repeat("0", decimals-len(frac))
+	result, ok := new(big.Int).SetString("0"+whole+frac, 10)
+	if !ok {
+		return nil, fmt.Errorf("无效的金额: %q", s)
+	}
+	if negative {
+		result.Neg(result)
+	}
+	return result, nil
+}
+
+// ParseEther 解析带单位的 ETH 金额，如 "1.5 ether"、"1.5ether"、"20 gwei"，单位不区分大小写
+// 没有单位时按 wei 解析，和之前只接受 wei 的参数兼容
+func ParseEther(s string) (*big.Int, error) {
+	value := strings.TrimSpace(s)
+	unit := Wei
+	// 数字之后的字母部分是单位
+	i := strings.IndexFunc(value, func(r rune) bool {
+		return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+	})
+	if i >= 0 {
+		name := strings.ToLower(value[i:])
+		u, ok := unitNames[name]
+		if !ok {
+			return nil, fmt.Errorf("未知的单位 %q", value[i:])
+		}
+		unit, value = u, strings.TrimSpace(value[:i])
+	}
+	return ParseUnits(value, int(unit))
+}
+
+// FormatUnits 把最小单位的金额按 decimals 位小数格式化，去掉末尾的 0，如 FormatUnits(12340000, 6) = "12.34"
+func FormatUnits(value *big.Int, decimals int) string {
+	digits := new(big.Int).Abs(value).String()
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+	if decimals <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if frac == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + frac
+}
+
+// Format 按指定单位格式化 wei，如 Format(1500000000000000000, Ether) = "1.5 ether"
+func Format(wei *big.Int, unit Unit) string {
+	return FormatUnits(wei, int(unit)) + " " + unit.String()
+}
+
+// FormatEther 按金额大小选择 ether、gwei 或 wei 格式化
+// 不小于 1 szabo 的金额用 ether，不小于 1 mwei 的用 gwei，其余用 wei
+func FormatEther(wei *big.Int) string {
+	abs := new(big.Int).Abs(wei)
+	switch {
+	case abs.Cmp(Szabo.Value()) >= 0:
+		return Format(wei, Ether)
+	case abs.Cmp(Mwei.Value()) >= 0:
+		return Format(wei, Gwei)
+	}
+	return Format(wei, Wei)
+}
+
+// FormatToken 格式化代币金额，如 FormatToken(12340000, 6, "USDC") = "12.34 USDC"
+func FormatToken(value *big.Int, decimals int, symbol string) string {
+	if symbol == "" {
+		return FormatUnits(value, decimals)
+	}
+	return FormatUnits(value, decimals) + " " + symbol
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,177 @@
+package units
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+func bigInt(s string) *big.Int {
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid number " + s)
+	}
+	return value
+}
+
+func TestParseEther(t *testing.T) {
+	maxUint256 := math.MaxBig256.String()
+	// want 为空时期望解析失败
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0", "0"},
+		{"0 ether", "0"},
+		{"1", "1"},
+		{"1 wei", "1"},
+		{"0.000000000000000001 ether", "1"},
+		{"1.5 ether", "1500000000000000000"},
+		{"1.5ether", "1500000000000000000"},
+		{"1.5 ETH", "1500000000000000000"},
+		{"20 gwei", "20000000000"},
+		{"0.5 gwei", "500000000"},
+		{"1.", "1"},
+		{".5 ether", "500000000000000000"},
+		{"+2 kwei", "2000"},
+		{"-1", "-1"},
+		{"-1.5 ether", "-1500000000000000000"},
+		{maxUint256, maxUint256},
+		{maxUint256 + " wei", maxUint256},
+		// 超出精度的部分全为 0 时允许
+		{"1.0000000000000000000 ether", "1000000000000000000"},
+		{"1.50 wei", ""},
+		// 超过 18 位小数
+		{"0.0000000000000000001 ether", ""},
+		{"1.1234567890123456789 ether", ""},
+		{"0.1 wei", ""},
+		{"0.0000000001 gwei", ""},
+		// 格式错误
+		{"", ""},
+		{".", ""},
+		{"-", ""},
+		{"--1", ""},
+		{"1.2.3", ""},
+		{"1,000", ""},
+		{"abc", ""},
+		{"1e18", ""},
+		{"0x10", ""},
+		{"1 foo", ""},
+		{"1 ether wei", ""},
+	}
+	for _, tt := range tests {
+		got, err := ParseEther(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseEther(%q) = %s, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseEther(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got.Cmp(bigInt(tt.want)) != 0 {
+			t.Errorf("ParseEther(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		in       string
+		decimals int
+		want     string
+	}{
+		{"12.34", 6, "12340000"},
+		{"0.000001", 6, "1"},
+		{"12", 0, "12"},
+		{"12.0", 0, "12"},
+		{"0.0000001", 6, ""},
+		{"12.5", 0, ""},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnits(tt.in, tt.decimals)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseUnits(%q, %d) = %s, want error", tt.in, tt.decimals, got)
+			}
+			continue
+		}
+		if err != nil || got.Cmp(bigInt(tt.want)) != 0 {
+			t.Errorf("ParseUnits(%q, %d) = %v, %v, want %s", tt.in, tt.decimals, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		wei  string
+		unit Unit
+		want string
+	}{
+		{"0", Ether, "0 ether"},
+		{"1", Ether, "0.000000000000000001 ether"},
+		{"1", Wei, "1 wei"},
+		{"1500000000000000000", Ether, "1.5 ether"},
+		{"-1500000000000000000", Ether, "-1.5 ether"},
+		{"20000000000", Gwei, "20 gwei"},
+		{"1", Gwei, "0.000000001 gwei"},
+		{math.MaxBig256.String(), Ether, "115792089237316195423570985008687907853269984665640564039457.584007913129639935 ether"},
+	}
+	for _, tt := range tests {
+		if got := Format(bigInt(tt.wei), tt.unit); got != tt.want {
+			t.Errorf("Format(%s, %s) = %q, want %q", tt.wei, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestFormatEther(t *testing.T) {
+	tests := []struct {
+		wei  string
+		want string
+	}{
+		{"0", "0 wei"},
+		{"1", "1 wei"},
+		{"999999", "999999 wei"},
+		{"1000000", "0.001 gwei"},
+		{"20000000000", "20 gwei"},
+		{"1000000000000", "0.000001 ether"},
+		{"1500000000000000000", "1.5 ether"},
+		{"-20000000000", "-20 gwei"},
+	}
+	for _, tt := range tests {
+		if got := FormatEther(bigInt(tt.wei)); got != tt.want {
+			t.Errorf("FormatEther(%s) = %q, want %q", tt.wei, got, tt.want)
+		}
+	}
+}
+
+// TestRoundTrip 格式化后再解析得到原来的金额
+func TestRoundTrip(t *testing.T) {
+	values := []string{"0", "1", "999999", "1000000", "20000000000", "123456789012345678", "1500000000000000000", "-1500000000000000000", math.MaxBig256.String()}
+	for _, s := range values {
+		wei := bigInt(s)
+		for _, unit := range []Unit{Wei, Gwei, Ether} {
+			formatted := Format(wei, unit)
+			got, err := ParseEther(formatted)
+			if err != nil || got.Cmp(wei) != 0 {
+				t.Errorf("ParseEther(Format(%s, %s) = %q) = %v, %v", s, unit, formatted, got, err)
+			}
+		}
+		formatted := FormatEther(wei)
+		if got, err := ParseEther(formatted); err != nil || got.Cmp(wei) != 0 {
+			t.Errorf("ParseEther(FormatEther(%s) = %q) = %v, %v", s, formatted, got, err)
+		}
+	}
+}
+
+func TestFormatToken(t *testing.T) {
+	if got := FormatToken(big.NewInt(12340000), 6, "USDC"); got != "12.34 USDC" {
+		t.Errorf("FormatToken = %q", got)
+	}
+	if got := FormatToken(big.NewInt(12340000), 6, ""); got != "12.34" {
+		t.Errorf("FormatToken without symbol = %q", got)
+	}
+}
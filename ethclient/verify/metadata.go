@@ -0,0 +1,51 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// solc 版本在 CBOR 中的编码: "solc" 键(0x64 736f6c63) 之后是 3 字节的 bytes(0x43)
+var solcKey = []byte{0x64, 's', 'o', 'l', 'c', 0x43}
+
+// Metadata 字节码末尾的 CBOR 元数据，包含源码元数据的 ipfs/bzzr 哈希和编译器版本
+// 格式: <CBOR map> <2 字节大端 CBOR 长度>
+type Metadata struct {
+	Raw []byte
+	// 如 0.8.30，元数据中没有版本时为空
+	Compiler string
+}
+
+// SplitMetadata 把字节码拆成代码部分和末尾的元数据，没有可识别的元数据时 meta 为 nil
+func SplitMetadata(code []byte) (body []byte, meta *Metadata) {
+	if len(code) < 2 {
+		return code, nil
+	}
+	n := int(binary.BigEndian.Uint16(code[len(code)-2:]))
+	start := len(code) - 2 - n
+	if n == 0 || start < 0 {
+		return code, nil
+	}
+	raw := code[start : len(code)-2]
+	// CBOR map 的首字节为 0xa0 | 键数量，solc 输出 1~3 个键
+	if raw[0] < 0xa1 || raw[0] > 0xa5 || !hasMetadataKey(raw) {
+		return code, nil
+	}
+	meta = &Metadata{Raw: code[start:]}
+	if i := bytes.Index(raw, solcKey); i >= 0 && i+len(solcKey)+3 <= len(raw) {
+		v := raw[i+len(solcKey):]
+		meta.Compiler = fmt.Sprintf("%d.%d.%d", v[0], v[1], v[2])
+	}
+	return code[:start], meta
+}
+
+// hasMetadataKey solc 元数据中一定有 ipfs、bzzr0/bzzr1 之一或 solc
+func hasMetadataKey(raw []byte) bool {
+	for _, key := range []string{"ipfs", "bzzr0", "bzzr1", "solc"} {
+		if bytes.Contains(raw, []byte(key)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,269 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"ethclient/abiargs"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+/**
+离线的合约部署校验，不依赖 Etherscan
+    用 CodeAt 读取链上的运行时字节码，和本地 .bin(或绑定 MetaData.Bin)中的运行时部分比较
+    忽略末尾的 CBOR 元数据，immutable 变量的位置单独列出
+    提供部署交易时，从交易 input 中去掉创建字节码得到构造参数，并按 ABI 解码
+*/
+
+// Backend 校验需要的链上访问能力，*ethclient.Client 和 simulated 后端都满足
+type Backend interface {
+	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// Artifact 本地编译产物
+type Artifact struct {
+	Name string
+	// solc --bin 输出的创建字节码
+	Creation []byte
+	// 用于解码构造参数，可以为 nil
+	ABI *abi.ABI
+}
+
+// ReadArtifact 读取 solc --bin 输出的文件，abiPath 为空时不解码构造参数
+func ReadArtifact(name, binPath, abiPath string) (*Artifact, error) {
+	data, err := os.ReadFile(binPath)
+	if err != nil {
+		return nil, err
+	}
+	code, err := decodeBin(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", binPath, err)
+	}
+	artifact := &Artifact{Name: name, Creation: code}
+	if abiPath != "" {
+		file, err := os.Open(abiPath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		parsed, err := abi.JSON(file)
+		if err != nil {
+			return nil, fmt.Errorf("解析 ABI %s 失败: %w", abiPath, err)
+		}
+		artifact.ABI = &parsed
+	}
+	return artifact, nil
+}
+
+// FromMetaData 使用 abigen 绑定中的 Bin 和 ABI
+func FromMetaData(name string, meta *bind.MetaData) (*Artifact, error) {
+	if meta.Bin == "" {
+		return nil, fmt.Errorf("%s 的绑定没有字节码", name)
+	}
+	code, err := decodeBin(meta.Bin)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Artifact{Name: name, Creation: code, ABI: parsed}, nil
+}
+
+func decodeBin(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "0x")
+	// 未链接的库地址占位符形如 __$...$__
+	if strings.Contains(s, "__") {
+		return nil, fmt.Errorf("字节码中有未链接的库占位符")
+	}
+	return hexutil.Decode("0x" + s)
+}
+
+// Status 校验结果
+type Status string
+
+const (
+	// 代码和元数据都一致
+	StatusMatch Status = "match"
+	// 代码一致，元数据不同(源码路径、注释或编译设置不同)
+	StatusPartial Status = "partial"
+	// 代码不一致
+	StatusMismatch Status = "mismatch"
+	// 地址上没有合约代码
+	StatusNoCode Status = "no-code"
+)
+
+// Immutable 运行时代码中 immutable 变量的位置和链上的值
+// 本地产物里这些位置是 PUSH32 0 占位，由构造函数在部署时写入
+type Immutable struct {
+	Offset int           `json:"offset"`
+	Value  hexutil.Bytes `json:"value"`
+}
+
+// Report 一个地址的校验结果
+type Report struct {
+	Address  common.Address `json:"address"`
+	Contract string         `json:"contract"`
+	Status   Status         `json:"status"`
+	// 第一个不一致的字节在链上代码中的偏移
+	FirstDiff       *int        `json:"firstDiff,omitempty"`
+	Immutables      []Immutable `json:"immutables,omitempty"`
+	MetadataMatch   bool        `json:"metadataMatch"`
+	Compiler        string      `json:"compiler,omitempty"`
+	LocalCompiler   string      `json:"localCompiler,omitempty"`
+	OnChainCodeSize int         `json:"onChainCodeSize"`
+	LocalCodeSize   int         `json:"localCodeSize"`
+	// 提供部署交易时的结果
+	CreationTx      *common.Hash           `json:"creationTx,omitempty"`
+	CreationMatch   *bool                  `json:"creationMatch,omitempty"`
+	ConstructorArgs hexutil.Bytes          `json:"constructorArgs,omitempty"`
+	DecodedArgs     map[string]interface{} `json:"decodedArgs,omitempty"`
+}
+
+// Verify 校验 address 上的代码是否由 artifact 部署，creationTx 不为 nil 时同时校验部署交易并取出构造参数
+func Verify(ctx context.Context, backend Backend, address common.Address, artifact *Artifact, creationTx *common.Hash) (*Report, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("读取 %s 的代码失败: %w", address.Hex(), err)
+	}
+	report := CompareRuntime(code, artifact.Creation)
+	report.Address = address
+	report.Contract = artifact.Name
+	if creationTx == nil {
+		return report, nil
+	}
+
+	tx, _, err := backend.TransactionByHash(ctx, *creationTx)
+	if err != nil {
+		return nil, fmt.Errorf("读取部署交易 %s 失败: %w", creationTx.Hex(), err)
+	}
+	if tx.To() != nil {
+		return nil, fmt.Errorf("%s 不是创建合约的交易，工厂合约创建的合约没有单独的部署交易", creationTx.Hex())
+	}
+	report.CreationTx = creationTx
+	args, ok := ConstructorArgs(tx.Data(), artifact.Creation)
+	report.CreationMatch = &ok
+	if !ok {
+		return report, nil
+	}
+	report.ConstructorArgs = args
+	if artifact.ABI != nil && len(artifact.ABI.Constructor.Inputs) > 0 {
+		values, err := artifact.ABI.Constructor.Inputs.Unpack(args)
+		if err != nil {
+			return nil, fmt.Errorf("解码构造参数失败: %w", err)
+		}
+		report.DecodedArgs = abiargs.FormatAll(artifact.ABI.Constructor.Inputs, values)
+	}
+	return report, nil
+}
+
+// CompareRuntime 比较链上运行时代码和本地创建字节码
+// solc 的创建字节码为 <初始化代码><运行时代码><运行时元数据>，去掉元数据后运行时代码是它的后缀
+func CompareRuntime(onChain, creation []byte) *Report {
+	report := &Report{OnChainCodeSize: len(onChain)}
+	if len(onChain) == 0 {
+		report.Status = StatusNoCode
+		return report
+	}
+	body, meta := SplitMetadata(onChain)
+	localBody, localMeta := SplitMetadata(creation)
+	if meta != nil {
+		report.Compiler = meta.Compiler
+	}
+	if localMeta != nil {
+		report.LocalCompiler = localMeta.Compiler
+	}
+	report.MetadataMatch = meta != nil && localMeta != nil && bytes.Equal(meta.Raw, localMeta.Raw)
+
+	if len(body) > len(localBody) {
+		report.Status = StatusMismatch
+		zero := 0
+		report.FirstDiff = &zero
+		return report
+	}
+	runtime := localBody[len(localBody)-len(body):]
+	report.LocalCodeSize = len(runtime)
+	if localMeta != nil {
+		report.LocalCodeSize += len(localMeta.Raw)
+	}
+
+	immutables, diff := compareCode(body, runtime)
+	if diff >= 0 {
+		report.Status = StatusMismatch
+		report.FirstDiff = &diff
+		return report
+	}
+	report.Immutables = immutables
+	if report.MetadataMatch {
+		report.Status = StatusMatch
+	} else {
+		report.Status = StatusPartial
+	}
+	return report
+}
+
+// compareCode 按指令逐条比较，本地为 PUSH32 0 而链上不同的位置视为 immutable
+// 返回 immutable 列表和第一个不一致的偏移，一致时偏移为 -1
+func compareCode(onChain, local []byte) ([]Immutable, int) {
+	var immutables []Immutable
+	for pc := 0; pc < len(local); {
+		op := vm.OpCode(local[pc])
+		size := 1
+		if op.IsPush() {
+			size += int(op - vm.PUSH0)
+		}
+		end := min(pc+size, len(local))
+		if !bytes.Equal(onChain[pc:end], local[pc:end]) {
+			immediate := local[pc+1 : end]
+			if op != vm.PUSH32 || onChain[pc] != local[pc] || len(immediate) != 32 || !isZero(immediate) {
+				for i := pc; i < end; i++ {
+					if onChain[i] != local[i] {
+						return nil, i
+					}
+				}
+			}
+			immutables = append(immutables, Immutable{Offset: pc + 1, Value: common.CopyBytes(onChain[pc+1 : end])})
+		}
+		pc = end
+	}
+	return immutables, -1
+}
+
+// ConstructorArgs 部署交易的 input 为 <创建字节码><ABI 编码的构造参数>
+// 忽略元数据比较 input 的前缀和本地创建字节码，一致时返回剩余部分
+func ConstructorArgs(input, creation []byte) ([]byte, bool) {
+	if len(input) < len(creation) {
+		return nil, false
+	}
+	body, meta := SplitMetadata(creation)
+	if !bytes.Equal(input[:len(body)], body) {
+		return nil, false
+	}
+	if meta != nil {
+		// 元数据长度相同时才能定位构造参数的起点
+		if _, inputMeta := SplitMetadata(input[:len(creation)]); inputMeta == nil {
+			return nil, false
+		}
+	}
+	return input[len(creation):], true
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
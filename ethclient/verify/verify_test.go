@@ -0,0 +1,152 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"ethclient/devchain"
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// tampered 把链上代码 offset 处的字节取反，模拟部署的代码被改动
+type tampered struct {
+	Backend
+	offset int
+}
+
+func (t *tampered) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	code, err := t.Backend.CodeAt(ctx, contract, blockNumber)
+	if err != nil || len(code) <= t.offset {
+		return code, err
+	}
+	code = common.CopyBytes(code)
+	code[t.offset] ^= 0xff
+	return code, nil
+}
+
+// deployCount 在内存链上部署 genCode 中的 Count
+func deployCount(t *testing.T) (*devchain.Chain, *Artifact, common.Address, common.Hash) {
+	t.Helper()
+	artifact, err := FromMetaData("Count", genCode.CountMetaData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, err := devchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	address, receipt, err := chain.Deploy(context.Background(), chain.Accounts[0], artifact.ABI, artifact.Creation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chain, artifact, address, receipt.TxHash
+}
+
+func TestVerifyDeployedCount(t *testing.T) {
+	chain, artifact, address, txHash := deployCount(t)
+
+	report, err := Verify(context.Background(), chain.Client, address, artifact, &txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != StatusMatch || !report.MetadataMatch {
+		t.Fatalf("status = %s, metadataMatch = %v, want match", report.Status, report.MetadataMatch)
+	}
+	if report.Compiler != "0.8.30" || report.LocalCompiler != "0.8.30" {
+		t.Fatalf("compiler = %q / %q, want 0.8.30", report.Compiler, report.LocalCompiler)
+	}
+	if report.OnChainCodeSize != report.LocalCodeSize {
+		t.Fatalf("链上代码 %d 字节，本地运行时代码 %d 字节", report.OnChainCodeSize, report.LocalCodeSize)
+	}
+	if report.CreationMatch == nil || !*report.CreationMatch || len(report.ConstructorArgs) != 0 {
+		t.Fatalf("creationMatch = %v, constructorArgs = %x", report.CreationMatch, report.ConstructorArgs)
+	}
+	if len(report.Immutables) != 0 {
+		t.Fatalf("Count 没有 immutable 变量: %+v", report.Immutables)
+	}
+}
+
+func TestVerifyTamperedCode(t *testing.T) {
+	chain, artifact, address, _ := deployCount(t)
+
+	code, err := chain.Client.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := SplitMetadata(code)
+	for _, offset := range []int{0, len(body) / 2, len(body) - 1} {
+		report, err := Verify(context.Background(), &tampered{Backend: chain.Client, offset: offset}, address, artifact, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Status != StatusMismatch || report.FirstDiff == nil || *report.FirstDiff != offset {
+			t.Fatalf("修改偏移 %d: status = %s, firstDiff = %v", offset, report.Status, report.FirstDiff)
+		}
+	}
+
+	// 只改动元数据时代码仍一致
+	report, err := Verify(context.Background(), &tampered{Backend: chain.Client, offset: len(body) + 10}, address, artifact, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != StatusPartial || report.MetadataMatch {
+		t.Fatalf("修改元数据: status = %s, metadataMatch = %v, want partial", report.Status, report.MetadataMatch)
+	}
+}
+
+func TestVerifyNoCode(t *testing.T) {
+	chain, artifact, _, _ := deployCount(t)
+	report, err := Verify(context.Background(), chain.Client, common.HexToAddress("0x1111111111111111111111111111111111111111"), artifact, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != StatusNoCode {
+		t.Fatalf("status = %s, want no-code", report.Status)
+	}
+}
+
+func TestCompareRuntimeImmutables(t *testing.T) {
+	value := bytes.Repeat([]byte{0xab}, 32)
+	// 本地: PUSH1 0x80 PUSH32 0 POP STOP，链上 PUSH32 的值由构造函数写入
+	local := append([]byte{byte(vm.PUSH1), 0x80, byte(vm.PUSH32)}, make([]byte, 32)...)
+	local = append(local, byte(vm.POP), byte(vm.STOP))
+	onChain := append([]byte{byte(vm.PUSH1), 0x80, byte(vm.PUSH32)}, value...)
+	onChain = append(onChain, byte(vm.POP), byte(vm.STOP))
+
+	report := CompareRuntime(onChain, local)
+	if report.Status != StatusPartial {
+		t.Fatalf("status = %s, want partial", report.Status)
+	}
+	if len(report.Immutables) != 1 || report.Immutables[0].Offset != 3 || !bytes.Equal(report.Immutables[0].Value, value) {
+		t.Fatalf("immutables = %+v", report.Immutables)
+	}
+
+	// 不是 PUSH32 0 的位置不一致时不能当作 immutable
+	onChain[1] = 0x60
+	report = CompareRuntime(onChain, local)
+	if report.Status != StatusMismatch || *report.FirstDiff != 1 {
+		t.Fatalf("status = %s, firstDiff = %v, want mismatch at 1", report.Status, report.FirstDiff)
+	}
+}
+
+func TestSplitMetadata(t *testing.T) {
+	code := common.FromHex(genCode.CountMetaData.Bin)
+	body, meta := SplitMetadata(code)
+	if meta == nil || meta.Compiler != "0.8.30" {
+		t.Fatalf("meta = %+v", meta)
+	}
+	if len(body)+len(meta.Raw) != len(code) {
+		t.Fatalf("body %d + meta %d != code %d", len(body), len(meta.Raw), len(code))
+	}
+
+	// 末尾不是 CBOR 元数据
+	plain := []byte{byte(vm.PUSH1), 0x00, byte(vm.STOP)}
+	if body, meta := SplitMetadata(plain); meta != nil || !bytes.Equal(body, plain) {
+		t.Fatalf("SplitMetadata(plain) = %x, %+v", body, meta)
+	}
+}
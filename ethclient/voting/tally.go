@@ -0,0 +1,64 @@
+package voting
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+/**
+solidity_task/task1/Voting.sol 的链下客户端
+    vote/resetVotes 发送交易
+    getVotes 按候选人列表读取票数
+    Tracker 从区块数据里还原每一轮投票，找出同一轮重复投票的账户
+*/
+
+// Tally 一个候选人的票数
+type Tally struct {
+	Candidate string   `json:"candidate"`
+	Votes     *big.Int `json:"votes"`
+}
+
+type Client struct {
+	voting *genCode.Voting
+}
+
+func NewClient(address common.Address, backend bind.ContractBackend) (*Client, error) {
+	voting, err := genCode.NewVoting(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{voting: voting}, nil
+}
+
+// Vote 给 candidate 投一票
+func (c *Client) Vote(opts *bind.TransactOpts, candidate string) (*types.Transaction, error) {
+	if candidate == "" {
+		return nil, fmt.Errorf("候选人不能为空")
+	}
+	return c.voting.Vote(opts, candidate)
+}
+
+// Reset 清空所有候选人的票数，开始新一轮
+func (c *Client) Reset(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return c.voting.ResetVotes(opts)
+}
+
+// Tally 按 candidates 的顺序读取票数，blockNumber 为 nil 时读取最新状态
+func (c *Client) Tally(ctx context.Context, candidates []string, blockNumber *big.Int) ([]Tally, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: blockNumber}
+	tallies := make([]Tally, 0, len(candidates))
+	for _, candidate := range candidates {
+		votes, err := c.voting.GetVotes(opts, candidate)
+		if err != nil {
+			return nil, fmt.Errorf("读取 %s 的票数失败: %w", candidate, err)
+		}
+		tallies = append(tallies, Tally{Candidate: candidate, Votes: votes})
+	}
+	return tallies, nil
+}
@@ -0,0 +1,157 @@
+package voting
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"ethclient/genCode"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ChainReader Tracker 需要的区块读取能力，*ethclient.Client 满足
+type ChainReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// Ballot 一笔成功的 vote 交易
+type Ballot struct {
+	Voter     common.Address `json:"voter"`
+	Candidate string         `json:"candidate"`
+	Block     uint64         `json:"block"`
+	TxHash    common.Hash    `json:"txHash"`
+}
+
+// DoubleVote 同一轮里投了不止一次的账户
+type DoubleVote struct {
+	Voter   common.Address `json:"voter"`
+	Ballots []Ballot       `json:"ballots"`
+}
+
+// Round 两次 resetVotes 之间的一轮投票
+type Round struct {
+	Index      int    `json:"index"`
+	StartBlock uint64 `json:"startBlock"`
+	EndBlock   uint64 `json:"endBlock"`
+	// 结束本轮的 resetVotes 交易，本轮仍在进行时为空
+	ResetTx      *common.Hash `json:"resetTx,omitempty"`
+	Results      []Tally      `json:"results"`
+	Ballots      []Ballot     `json:"ballots"`
+	DoubleVoters []DoubleVote `json:"doubleVoters"`
+}
+
+type Tracker struct {
+	backend  ChainReader
+	contract common.Address
+	abi      *abi.ABI
+}
+
+func NewTracker(backend ChainReader, contract common.Address) (*Tracker, error) {
+	parsed, err := genCode.VotingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Tracker{
+		backend:  backend,
+		contract: contract,
+		abi:      parsed,
+	}, nil
+}
+
+// Rounds 扫描 [from, to] 区块中发往合约的交易，按 resetVotes 切分成轮次
+// 合约只记录票数不记录投票人，所以结果只包含扫描范围内的交易：from 应不晚于本轮开始的区块。
+func (t *Tracker) Rounds(ctx context.Context, from, to uint64) ([]*Round, error) {
+	chainID, err := t.backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+
+	round := &Round{StartBlock: from}
+	var rounds []*Round
+	for number := from; number <= to; number++ {
+		block, err := t.backend.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("读取区块 %d 失败: %w", number, err)
+		}
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != t.contract || len(tx.Data()) < 4 {
+				continue
+			}
+			method, err := t.abi.MethodById(tx.Data()[:4])
+			if err != nil {
+				continue
+			}
+			receipt, err := t.backend.TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				return nil, fmt.Errorf("读取交易 %s 收据失败: %w", tx.Hash().Hex(), err)
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				continue
+			}
+
+			switch method.Name {
+			case "vote":
+				args, err := method.Inputs.Unpack(tx.Data()[4:])
+				if err != nil {
+					return nil, fmt.Errorf("解析交易 %s 失败: %w", tx.Hash().Hex(), err)
+				}
+				sender, err := types.Sender(signer, tx)
+				if err != nil {
+					return nil, err
+				}
+				round.Ballots = append(round.Ballots, Ballot{
+					Voter:     sender,
+					Candidate: args[0].(string),
+					Block:     number,
+					TxHash:    tx.Hash(),
+				})
+			case "resetVotes":
+				hash := tx.Hash()
+				round.EndBlock = number
+				round.ResetTx = &hash
+				rounds = append(rounds, round.finish())
+				round = &Round{Index: len(rounds), StartBlock: number}
+			}
+		}
+	}
+	round.EndBlock = to
+	return append(rounds, round.finish()), nil
+}
+
+// finish 统计票数并找出重复投票的账户
+func (r *Round) finish() *Round {
+	counts := make(map[string]int64)
+	byVoter := make(map[common.Address][]Ballot)
+	var voters []common.Address
+	for _, ballot := range r.Ballots {
+		counts[ballot.Candidate]++
+		if _, ok := byVoter[ballot.Voter]; !ok {
+			voters = append(voters, ballot.Voter)
+		}
+		byVoter[ballot.Voter] = append(byVoter[ballot.Voter], ballot)
+	}
+
+	r.Results = make([]Tally, 0, len(counts))
+	for candidate, votes := range counts {
+		r.Results = append(r.Results, Tally{Candidate: candidate, Votes: big.NewInt(votes)})
+	}
+	sort.Slice(r.Results, func(i, j int) bool {
+		if c := r.Results[i].Votes.Cmp(r.Results[j].Votes); c != 0 {
+			return c > 0
+		}
+		return r.Results[i].Candidate < r.Results[j].Candidate
+	})
+
+	for _, voter := range voters {
+		if len(byVoter[voter]) > 1 {
+			r.DoubleVoters = append(r.DoubleVoters, DoubleVote{Voter: voter, Ballots: byVoter[voter]})
+		}
+	}
+	return r
+}
@@ -47,6 +47,10 @@ pragma solidity ^0.8;
  */
 
  contract BeggingContract{ 
+    // 捐赠事件，链下服务据此记录捐赠和排行榜
+    event Donation(address indexed donor, uint256 amount);
+    event Withdrawal(address indexed owner, uint256 amount);
+
     address payable private owner;
     // 记录每个捐赠者的捐赠金额
     mapping(address => uint256) public donations;
@@ -59,9 +63,11 @@ pragma solidity ^0.8;
     }
 
     function withdraw() public onlyOwner {
-        require(address(this).balance > 0, "No balance to withdraw.");
+        uint256 amount = address(this).balance;
+        require(amount > 0, "No balance to withdraw.");
         // 提取所有捐赠的资金
-        payable(owner).transfer(address(this).balance);
+        payable(owner).transfer(amount);
+        emit Withdrawal(owner, amount);
     }
     // 查询某个地址的捐赠金额
     function getDonation(address _address) public view returns (uint256) {
@@ -73,6 +79,7 @@ pragma solidity ^0.8;
     function donate() public payable {
         // 存储捐赠者的地址和金额
         donations[msg.sender] += msg.value;
+        emit Donation(msg.sender, msg.value);
     }
 
  }
@@ -21,4 +21,8 @@ var (
 	ErrInvalidParams      = NewCodeError(10005, "请求参数错误")
 	ErrPostNotFound       = NewCodeError(10006, "文章不存在")
 	ErrCommentNotFound    = NewCodeError(10007, "评论不存在")
+	ErrCategoryNotFound   = NewCodeError(10008, "分类不存在")
+	ErrWalletNotLinked    = NewCodeError(10009, "钱包未绑定用户")
+	ErrWalletLinked       = NewCodeError(10010, "钱包已被其他用户绑定")
+	ErrRefreshConflict    = NewCodeError(10011, "登录状态正在刷新，请稍后重试")
 )
@@ -0,0 +1,285 @@
+package types
+
+import (
+	"time"
+
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/search"
+)
+
+/**
+接口返回的数据结构
+    handler 只序列化这里的结构体，不直接返回数据库模型，避免密码哈希等字段随模型一起输出
+    字段按可见范围分为：所有人可见、本人可见、管理员可见，不可见的字段置空并在 JSON 中省略
+*/
+
+// Visibility 查看者对数据的可见范围
+type Visibility int
+
+const (
+	// VisibilityPublic 所有人可见的字段
+	VisibilityPublic Visibility = iota
+	// VisibilityOwner 本人可见的字段，包含 VisibilityPublic
+	VisibilityOwner
+	// VisibilityAdmin 管理员可见的字段，包含 VisibilityOwner
+	VisibilityAdmin
+)
+
+// VisibilityFor 根据查看者和数据所有者计算可见范围，只有管理员可以查看其他用户的私有字段
+func VisibilityFor(viewerID uint, viewerRole string, ownerID uint) Visibility {
+	switch {
+	case auth.Can(viewerRole, auth.PermUserPrivate):
+		return VisibilityAdmin
+	case viewerID != 0 && viewerID == ownerID:
+		return VisibilityOwner
+	default:
+		return VisibilityPublic
+	}
+}
+
+type UserResp struct {
+	ID        uint      `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+	// 本人可见
+	Email string `json:"email,omitempty"`
+	Role  string `json:"role,omitempty"`
+	// 绑定的钱包地址
+	WalletAddress string `json:"wallet_address,omitempty"`
+	// 管理员可见
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type PostResp struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	UserID    uint      `json:"user_id"`
+	// 未分类时为空
+	Category *CategoryResp `json:"category,omitempty"`
+	Tags     []string      `json:"tags"`
+	// 只在回收站中有值
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 被版主删除，作者不能自行恢复
+	DeletedByModerator bool `json:"deleted_by_moderator,omitempty"`
+}
+
+type TagResp struct {
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"`
+}
+
+type CategoryResp struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"`
+}
+
+// PostPublicResp 公开页面中的文章，带作者和评论数
+type PostPublicResp struct {
+	*PostResp
+	Author       *UserResp `json:"author"`
+	CommentCount int64     `json:"comment_count"`
+}
+
+// CommentResp 回复树中已删除的评论只保留位置，不输出内容和作者
+type CommentResp struct {
+	ID        uint           `json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	Content   string         `json:"content"`
+	UserID    uint           `json:"user_id,omitempty"`
+	PostID    uint           `json:"post_id"`
+	ParentID  *uint          `json:"parent_id,omitempty"`
+	Deleted   bool           `json:"deleted,omitempty"`
+	Replies   []*CommentResp `json:"replies,omitempty"`
+	// 只在回收站中有值
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 被版主删除，作者不能自行恢复
+	DeletedByModerator bool `json:"deleted_by_moderator,omitempty"`
+}
+
+// SiweNonceResp 前端用这些字段组装待签名的 SIWE 消息
+type SiweNonceResp struct {
+	Domain         string    `json:"domain"`
+	URI            string    `json:"uri"`
+	ChainID        int64     `json:"chain_id"`
+	Nonce          string    `json:"nonce"`
+	IssuedAt       time.Time `json:"issued_at"`
+	ExpirationTime time.Time `json:"expiration_time"`
+}
+
+// NewUserResp 按可见范围转换用户，密码在任何范围下都不输出
+func NewUserResp(user *User, v Visibility) *UserResp {
+	resp := &UserResp{
+		ID:        user.ID,
+		Username:  user.Username,
+		CreatedAt: user.CreatedAt,
+	}
+	if v >= VisibilityOwner {
+		resp.Email = user.Email
+		resp.Role = user.Role
+		if user.WalletAddress != nil {
+			resp.WalletAddress = *user.WalletAddress
+		}
+	}
+	if v >= VisibilityAdmin {
+		updatedAt := user.UpdatedAt
+		resp.UpdatedAt = &updatedAt
+	}
+	return resp
+}
+
+// NewPostResp 分类和标签需要已经加载
+func NewPostResp(post *Post) *PostResp {
+	resp := &PostResp{
+		ID:        post.ID,
+		CreatedAt: post.CreatedAt,
+		UpdatedAt: post.UpdatedAt,
+		Title:     post.Title,
+		Content:   post.Content,
+		UserID:    post.UserID,
+		Tags:      post.TagNames(),
+	}
+	if post.Category != nil {
+		resp.Category = &CategoryResp{ID: post.Category.ID, Name: post.Category.Name}
+	}
+	return resp
+}
+
+func NewTagResp(tag *Tag) *TagResp {
+	return &TagResp{
+		Name:      tag.Name,
+		PostCount: tag.PostCount,
+	}
+}
+
+func NewCategoryResp(category *Category) *CategoryResp {
+	return &CategoryResp{
+		ID:        category.ID,
+		Name:      category.Name,
+		PostCount: category.PostCount,
+	}
+}
+
+// NewTrashPostResp 回收站中的文章，带删除时间
+func NewTrashPostResp(post *Post) *PostResp {
+	resp := NewPostResp(post)
+	if post.DeletedAt.Valid {
+		resp.DeletedAt = &post.DeletedAt.Time
+	}
+	resp.DeletedByModerator = post.DeletedByModerator()
+	return resp
+}
+
+// NewPostPublicResp 作者只输出公开字段，作者不存在时为 nil；评论数需要已经计算
+func NewPostPublicResp(post *Post, author *User) *PostPublicResp {
+	resp := &PostPublicResp{
+		PostResp:     NewPostResp(post),
+		CommentCount: post.CommentCount,
+	}
+	if author != nil {
+		resp.Author = NewUserResp(author, VisibilityPublic)
+	}
+	return resp
+}
+
+func NewCommentResp(comment *Comment) *CommentResp {
+	resp := &CommentResp{
+		ID:        comment.ID,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		Content:   comment.Content,
+		UserID:    comment.UserID,
+		PostID:    comment.PostID,
+		ParentID:  comment.ParentID,
+		Deleted:   comment.DeletedAt.Valid,
+	}
+	if resp.Deleted {
+		resp.Content = ""
+		resp.UserID = 0
+	}
+	return resp
+}
+
+// NewTrashCommentResp 回收站中的评论，作者本人查看，保留内容
+func NewTrashCommentResp(comment *Comment) *CommentResp {
+	resp := &CommentResp{
+		ID:        comment.ID,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		Content:   comment.Content,
+		UserID:    comment.UserID,
+		PostID:    comment.PostID,
+		ParentID:  comment.ParentID,
+		Deleted:   true,
+	}
+	if comment.DeletedAt.Valid {
+		resp.DeletedAt = &comment.DeletedAt.Time
+	}
+	resp.DeletedByModerator = comment.DeletedByModerator()
+	return resp
+}
+
+// NewCommentTree 把顶层评论和它们的回复组装成树，回复按 replies 中的顺序排列
+// 父评论不在 replies 中的回复会被丢弃，已删除且没有未删除回复的评论会被去掉
+func NewCommentTree(roots []*CommentResp, replies []Comment) []*CommentResp {
+	nodes := make(map[uint]*CommentResp, len(roots)+len(replies))
+	for _, root := range roots {
+		nodes[root.ID] = root
+	}
+	for i := range replies {
+		nodes[replies[i].ID] = NewCommentResp(&replies[i])
+	}
+	for i := range replies {
+		if replies[i].ParentID == nil {
+			continue
+		}
+		if parent, ok := nodes[*replies[i].ParentID]; ok {
+			parent.Replies = append(parent.Replies, nodes[replies[i].ID])
+		}
+	}
+	return pruneDeleted(roots)
+}
+
+// pruneDeleted 去掉已删除且所有回复都已删除的评论
+func pruneDeleted(comments []*CommentResp) []*CommentResp {
+	kept := comments[:0]
+	for _, c := range comments {
+		c.Replies = pruneDeleted(c.Replies)
+		if !c.Deleted || len(c.Replies) > 0 {
+			kept = append(kept, c)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
+
+// SearchHitResp 搜索结果，title、snippet 中命中的词用 <em></em> 包裹，其余部分已转义
+type SearchHitResp struct {
+	Kind      string    `json:"kind"`
+	ID        uint      `json:"id"`
+	PostID    uint      `json:"post_id"`
+	UserID    uint      `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Score     float64   `json:"score"`
+	Title     string    `json:"title,omitempty"`
+	Snippet   string    `json:"snippet"`
+}
+
+func NewSearchHitResp(hit *search.Hit) *SearchHitResp {
+	return &SearchHitResp{
+		Kind:      hit.Kind,
+		ID:        hit.ID,
+		PostID:    hit.PostID,
+		UserID:    hit.UserID,
+		CreatedAt: hit.CreatedAt,
+		Score:     hit.Score,
+		Title:     hit.Title,
+		Snippet:   hit.Snippet,
+	}
+}
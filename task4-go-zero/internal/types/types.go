@@ -1,15 +1,62 @@
 package types
 
-import "time"
+import (
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"task4-go-zero/pkg/pagination"
+	"task4-go-zero/pkg/search"
+)
 
 type User struct {
 	ID        uint      `json:"id" db:"id"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 	Username  string    `json:"username" db:"username"`
-	Password  string    `json:"password" db:"password"`
-	Email     string    `json:"email" db:"email"`
-	Role      string    `json:"role" db:"role"`
+	// 只在数据库中使用，接口返回 UserResp
+	Password string `json:"-" db:"password"`
+	Email    string `json:"email" db:"email"`
+	Role     string `json:"role" db:"role"`
+	// 绑定的钱包地址，EIP-55 校验和格式，未绑定时为空
+	WalletAddress *string `json:"wallet_address" db:"wallet_address" gorm:"uniqueIndex;size:42"`
+}
+
+// SiweNonce 签发给钱包登录的 nonce，只能使用一次
+type SiweNonce struct {
+	ID        uint       `json:"id" db:"id"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	Nonce     string     `json:"nonce" db:"nonce" gorm:"uniqueIndex;size:32"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at" gorm:"index"`
+	UsedAt    *time.Time `json:"used_at" db:"used_at"`
+}
+
+// RefreshToken 服务端保存的 refresh token，每次刷新都换成新的一条
+// 同一次登录产生的 token 属于同一个 FamilyID，已经用过的 token 再次使用时整个 family 都会被吊销
+type RefreshToken struct {
+	ID        uint      `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UserID    uint      `json:"user_id" db:"user_id" gorm:"index"`
+	FamilyID  string    `json:"family_id" db:"family_id" gorm:"index;size:32"`
+	// token 的 SHA-256，不保存明文
+	TokenHash string    `json:"-" db:"token_hash" gorm:"uniqueIndex;size:64"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at" gorm:"index"`
+	// 和这个 refresh token 一起签发的 access token，吊销会话时一起吊销
+	AccessJTI       string    `json:"-" db:"access_jti" gorm:"index;size:32"`
+	AccessExpiresAt time.Time `json:"-" db:"access_expires_at"`
+	// 刷新时换成了新 token 的时间
+	UsedAt *time.Time `json:"used_at" db:"used_at"`
+	// 退出登录或检测到重用时吊销的时间
+	RevokedAt *time.Time `json:"revoked_at" db:"revoked_at"`
+}
+
+// RevokedToken 已吊销但还没过期的 access token，过期后删除
+type RevokedToken struct {
+	ID        uint      `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	JTI       string    `json:"jti" db:"jti" gorm:"uniqueIndex;size:32"`
+	UserID    uint      `json:"user_id" db:"user_id"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at" gorm:"index"`
 }
 
 type Post struct {
@@ -19,6 +66,35 @@ type Post struct {
 	Title     string    `json:"title" db:"title"`
 	Content   string    `json:"content" db:"content"`
 	UserID    uint      `json:"user_id" db:"user_id"`
+	// 软删除，删除时评论一起软删除，超过保留期后彻底删除
+	DeletedAt gorm.DeletedAt `json:"deleted_at" db:"deleted_at" gorm:"index"`
+	// 删除文章的用户，不是作者时说明被版主删除，作者不能自行恢复
+	DeletedBy uint `json:"deleted_by" db:"deleted_by"`
+	// 分类，为空表示未分类
+	CategoryID *uint     `json:"category_id" db:"category_id" gorm:"index"`
+	Category   *Category `json:"category"`
+	Tags       []Tag     `json:"tags" gorm:"many2many:post_tags"`
+	// 评论数，只在查询时通过子查询计算，不是表中的列
+	CommentCount int64 `json:"comment_count" gorm:"->;-:migration"`
+}
+
+// Tag 标签，名称统一为小写
+type Tag struct {
+	ID        uint      `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	Name      string    `json:"name" db:"name" gorm:"uniqueIndex;size:64"`
+	// 未删除的文章数，只在查询时计算
+	PostCount int64 `json:"post_count" gorm:"->;-:migration"`
+}
+
+// Category 分类，由管理员创建
+type Category struct {
+	ID        uint      `json:"id" db:"id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	Name      string    `json:"name" db:"name" gorm:"uniqueIndex;size:64"`
+	// 未删除的文章数，只在查询时计算
+	PostCount int64 `json:"post_count" gorm:"->;-:migration"`
 }
 
 type Comment struct {
@@ -28,6 +104,74 @@ type Comment struct {
 	Content   string    `json:"content" db:"content"`
 	UserID    uint      `json:"user_id" db:"user_id"`
 	PostID    uint      `json:"post_id" db:"post_id"`
+	// 回复的评论，顶层评论为空
+	ParentID *uint `json:"parent_id" db:"parent_id" gorm:"index"`
+	// 所在顶层评论，顶层评论为 0，用于按顶层评论分页时一次查出整棵回复树
+	RootID uint `json:"root_id" db:"root_id" gorm:"index"`
+	// 顶层评论为 0
+	Depth int `json:"depth" db:"depth"`
+	// 软删除，有未删除回复的评论在回复树中显示为占位
+	DeletedAt gorm.DeletedAt `json:"deleted_at" db:"deleted_at" gorm:"index"`
+	// 删除评论的用户，随文章删除时为删除文章的用户
+	DeletedBy uint `json:"deleted_by" db:"deleted_by"`
+}
+
+// PageKey 游标分页的排序键
+func (u User) PageKey() pagination.Key {
+	return pagination.Key{CreatedAt: u.CreatedAt, ID: u.ID}
+}
+
+func (p Post) PageKey() pagination.Key {
+	return pagination.Key{CreatedAt: p.CreatedAt, ID: p.ID}
+}
+
+// DeletedByModerator 是否被作者以外的用户(版主或管理员)删除，旧数据没有记录删除者时视为作者删除
+func (p *Post) DeletedByModerator() bool {
+	return p.DeletedBy != 0 && p.DeletedBy != p.UserID
+}
+
+func (c *Comment) DeletedByModerator() bool {
+	return c.DeletedBy != 0 && c.DeletedBy != c.UserID
+}
+
+func (c Comment) PageKey() pagination.Key {
+	return pagination.Key{CreatedAt: c.CreatedAt, ID: c.ID}
+}
+
+// SearchDocument 搜索索引中的文档
+// 文章的标签需要已经加载
+func (p *Post) SearchDocument() search.Document {
+	return search.Document{
+		Kind:      search.KindPost,
+		ID:        p.ID,
+		PostID:    p.ID,
+		UserID:    p.UserID,
+		Title:     p.Title,
+		Content:   p.Content,
+		Tags:      p.TagNames(),
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+// TagNames 已加载的标签名，按名称排序
+func (p *Post) TagNames() []string {
+	names := make([]string, 0, len(p.Tags))
+	for _, t := range p.Tags {
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Comment) SearchDocument() search.Document {
+	return search.Document{
+		Kind:      search.KindComment,
+		ID:        c.ID,
+		PostID:    c.PostID,
+		UserID:    c.UserID,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+	}
 }
 
 type UserLoginReq struct {
@@ -37,6 +181,19 @@ type UserLoginReq struct {
 
 type UserLoginResp struct {
 	Token string `json:"token"`
+	// access token 的有效期，单位秒
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokenRefreshReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// SiweReq 钱包签名的 SIWE 消息原文和签名，签名为 0x 开头的 65 字节十六进制
+type SiweReq struct {
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
 }
 
 type UserRegisterReq struct {
@@ -46,23 +203,32 @@ type UserRegisterReq struct {
 }
 
 type UserPageReq struct {
-	Page     int `form:"page"`
-	PageSize int `form:"pageSize"`
+	pagination.Params
+}
+
+type UserRoleReq struct {
+	UserID uint   `json:"user_id"`
+	Role   string `json:"role"`
 }
 
 type PostCreateReq struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title      string   `json:"title"`
+	Content    string   `json:"content"`
+	CategoryID uint     `json:"category_id,optional"`
+	Tags       []string `json:"tags,optional"`
 }
 
+// PostEditReq 不传 tags 时标签不变，传空数组时清空；category_id 为 0 时取消分类
 type PostEditReq struct {
-	ID      uint   `json:"id"`
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	ID         uint     `json:"id"`
+	Title      string   `json:"title"`
+	Content    string   `json:"content"`
+	CategoryID *uint    `json:"category_id,optional"`
+	Tags       []string `json:"tags,optional"`
 }
 
 type PostDeleteReq struct {
-	PostID uint `form:"postId"`
+	ID uint `json:"id"`
 }
 
 type PostDetailReq struct {
@@ -70,15 +236,100 @@ type PostDetailReq struct {
 }
 
 type PostPageReq struct {
-	Page     int `form:"page"`
-	PageSize int `form:"pageSize"`
+	pagination.Params
+}
+
+// 公开文章列表的排序方式
+const (
+	PostSortNewest   = "newest"
+	PostSortComments = "comments"
+)
+
+// 按评论数排序时不支持游标
+type PostFeedReq struct {
+	pagination.Params
+	Sort string `form:"sort,optional,options=newest|comments"`
+}
+
+type AuthorPostsReq struct {
+	pagination.Params
+	UserID uint   `path:"id"`
+	Sort   string `form:"sort,optional,options=newest|comments"`
+}
+
+type PostPublicReq struct {
+	ID uint `path:"id"`
 }
 
 type CommentCreateReq struct {
 	PostID  uint   `json:"post_id"`
 	Content string `json:"content"`
+	// 回复的评论ID，不填为顶层评论
+	ParentID uint `json:"parent_id,optional"`
+}
+
+type CommentEditReq struct {
+	ID      uint   `json:"id"`
+	Content string `json:"content"`
+}
+
+type CommentDeleteReq struct {
+	ID uint `json:"id"`
+}
+
+// SearchReq 搜索文章或评论，只支持页码分页
+// from、to 为 2006-01-02 或 RFC3339 格式，只有日期时 to 包含当天
+type SearchReq struct {
+	pagination.Params
+	Q        string `form:"q"`
+	Type     string `form:"type,optional,options=post|comment"`
+	AuthorID uint   `form:"authorId,optional"`
+	// 标签只对文章生效
+	Tag  string `form:"tag,optional"`
+	From string `form:"from,optional"`
+	To   string `form:"to,optional"`
+}
+
+type TagSuggestReq struct {
+	Prefix string `form:"prefix"`
+	Limit  int    `form:"limit,optional"`
+}
+
+type TagPageReq struct {
+	pagination.Params
+}
+
+type TagPostsReq struct {
+	pagination.Params
+	Name string `path:"name"`
+	Sort string `form:"sort,optional,options=newest|comments"`
+}
+
+type CategoryCreateReq struct {
+	Name string `json:"name"`
+}
+
+type CategoryPageReq struct {
+	pagination.Params
+}
+
+type CategoryPostsReq struct {
+	pagination.Params
+	ID   uint   `path:"id"`
+	Sort string `form:"sort,optional,options=newest|comments"`
+}
+
+// TrashRestoreReq 从回收站恢复文章或评论
+type TrashRestoreReq struct {
+	ID uint `json:"id"`
+}
+
+type TrashPageReq struct {
+	pagination.Params
 }
 
+// CommentListReq 按顶层评论分页，每条顶层评论带完整的回复树
 type CommentListReq struct {
+	pagination.Params
 	PostID uint `form:"postId"`
 }
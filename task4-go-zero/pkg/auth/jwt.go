@@ -13,11 +13,26 @@ type Claims struct {
 }
 
 func GenerateToken(userID uint, role, secret string, expiry int) (string, error) {
-	expirationTime := time.Now().Add(time.Duration(expiry) * time.Second)
+	tokenString, _, err := IssueToken(userID, role, secret, expiry)
+	return tokenString, err
+}
+
+// IssueToken 生成 token 并返回其中的 claims，claims.ID 为 jti，用于吊销
+func IssueToken(userID uint, role, secret string, expiry int) (string, *Claims, error) {
+	jti, err := NewTokenID()
+	if err != nil {
+		logx.Errorf("Generate token id error: %v", err)
+		return "", nil, err
+	}
+
+	now := time.Now()
+	expirationTime := now.Add(time.Duration(expiry) * time.Second)
 	claims := &Claims{
 		UserID: userID,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
@@ -25,16 +40,16 @@ func GenerateToken(userID uint, role, secret string, expiry int) (string, error)
 	tokenString, err := token.SignedString([]byte(secret))
 	if err != nil {
 		logx.Errorf("Generate token error: %v", err)
-		return "", err
+		return "", nil, err
 	}
-	return tokenString, nil
+	return tokenString, claims, nil
 }
 
 func ParseToken(tokenString, secret string) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(secret), nil
-	})
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
 
 	if err != nil {
 		logx.Errorf("Parse token error: %v", err)
@@ -0,0 +1,81 @@
+package auth
+
+// 角色，按权限从低到高
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// Permission 一项操作权限
+type Permission string
+
+const (
+	PermPostCreate Permission = "post:create"
+	PermPostRead   Permission = "post:read"
+	// 编辑、删除自己的文章
+	PermPostEdit   Permission = "post:edit"
+	PermPostDelete Permission = "post:delete"
+	// 删除任何人的文章
+	PermPostDeleteAny Permission = "post:delete:any"
+
+	PermCommentCreate Permission = "comment:create"
+	PermCommentRead   Permission = "comment:read"
+	// 编辑、删除自己的评论
+	PermCommentEdit   Permission = "comment:edit"
+	PermCommentDelete Permission = "comment:delete"
+	// 删除任何人的评论
+	PermCommentDeleteAny Permission = "comment:delete:any"
+
+	// 查看自己的资料
+	PermUserSelf Permission = "user:self"
+	// 查看回收站、恢复自己删除的内容
+	PermTrash    Permission = "trash"
+	PermUserList Permission = "user:list"
+	// 修改用户角色
+	PermUserRole Permission = "user:role"
+	// 查看其他用户的私有字段
+	PermUserPrivate Permission = "user:private"
+	// 管理文章分类
+	PermCategoryManage Permission = "category:manage"
+)
+
+var userPermissions = []Permission{
+	PermPostCreate, PermPostRead, PermPostEdit, PermPostDelete,
+	PermCommentCreate, PermCommentRead, PermCommentEdit, PermCommentDelete,
+	PermUserSelf, PermTrash,
+}
+
+var moderatorPermissions = append(append([]Permission{}, userPermissions...),
+	PermPostDeleteAny, PermCommentDeleteAny, PermUserList,
+)
+
+var adminPermissions = append(append([]Permission{}, moderatorPermissions...),
+	PermUserRole, PermUserPrivate, PermCategoryManage,
+)
+
+// rolePermissions 每个角色拥有的权限，高级角色包含低级角色的全部权限
+var rolePermissions = map[string]map[Permission]bool{
+	RoleUser:      permissionSet(userPermissions),
+	RoleModerator: permissionSet(moderatorPermissions),
+	RoleAdmin:     permissionSet(adminPermissions),
+}
+
+func permissionSet(perms []Permission) map[Permission]bool {
+	set := make(map[Permission]bool, len(perms))
+	for _, p := range perms {
+		set[p] = true
+	}
+	return set
+}
+
+// ValidRole 是否为已知角色
+func ValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Can 角色是否拥有权限，未知角色没有任何权限
+func Can(role string, perm Permission) bool {
+	return rolePermissions[role][perm]
+}
@@ -1 +1,235 @@
 package pagination
+
+/**
+通用分页
+    页码模式：page、pageSize，返回总数和总页数
+    游标模式：按 (created_at, id) 定位，cursor 不透明，不统计总数，翻页时不受新插入数据的影响
+    两种模式返回同样的 Page[T]，按时间排序时每页都会带上 nextCursor，客户端可以随时从页码切换到游标
+*/
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+var ErrInvalidCursor = errors.New("pagination: invalid cursor")
+
+// Params 分页请求参数，可以直接嵌入请求结构体
+type Params struct {
+	Page     int    `form:"page,optional"`
+	PageSize int    `form:"pageSize,optional"`
+	Cursor   string `form:"cursor,optional"`
+}
+
+// Page 一页数据，游标模式下 Total、Page、TotalPages 为 0 并省略
+type Page[T any] struct {
+	Data        []T    `json:"data"`
+	Total       int64  `json:"total,omitempty"`
+	Page        int    `json:"page,omitempty"`
+	PageSize    int    `json:"pageSize"`
+	TotalPages  int    `json:"totalPages,omitempty"`
+	HasNextPage bool   `json:"hasNextPage"`
+	NextCursor  string `json:"nextCursor,omitempty"`
+}
+
+// Normalize 补全默认值，返回页码和每页数量
+func (p Params) Normalize() (page, pageSize int) {
+	page, pageSize = p.Page, p.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+	return page, pageSize
+}
+
+// NewOffsetPage 用已经查出的一页数据生成页码模式的 Page，用于不经过数据库分页的列表
+func NewOffsetPage[T any](data []T, total int64, page, pageSize int) *Page[T] {
+	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+	if data == nil {
+		data = []T{}
+	}
+	return &Page[T]{
+		Data:        data,
+		Total:       total,
+		Page:        page,
+		PageSize:    pageSize,
+		TotalPages:  totalPages,
+		HasNextPage: page < totalPages,
+	}
+}
+
+// Key 游标定位的排序键
+type Key struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uint      `json:"id"`
+}
+
+// Keyed 可以按 (created_at, id) 分页的数据
+type Keyed interface {
+	PageKey() Key
+}
+
+// EncodeCursor 把排序键编码为游标
+func EncodeCursor(key Key) string {
+	data, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor 解析 EncodeCursor 生成的游标
+func DecodeCursor(cursor string) (Key, error) {
+	var key Key
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, &key); err != nil || key.ID == 0 {
+		return Key{}, ErrInvalidCursor
+	}
+	return key, nil
+}
+
+// Paginator 分页方式，一个列表接口对应一个 Paginator
+type Paginator struct {
+	// 排序列，联表查询时需要带表名，如 "posts.created_at"
+	TimeColumn string
+	IDColumn   string
+	// 为 true 时从旧到新
+	Asc bool
+	// 自定义排序，设置后只能使用页码模式
+	Order string
+	// 查询数据时使用的列，统计总数时不使用，用于附带子查询计算的列
+	Select string
+}
+
+// New 按 table 的 created_at、id 从新到旧分页
+func New(table string) *Paginator {
+	return &Paginator{
+		TimeColumn: table + ".created_at",
+		IDColumn:   table + ".id",
+	}
+}
+
+// Ascending 改为从旧到新
+func (p *Paginator) Ascending() *Paginator {
+	c := *p
+	c.Asc = true
+	return &c
+}
+
+// OrderBy 使用自定义排序，如按评论数
+func (p *Paginator) OrderBy(order string) *Paginator {
+	c := *p
+	c.Order = order
+	return &c
+}
+
+// Selecting 设置查询数据时使用的列
+func (p *Paginator) Selecting(columns string) *Paginator {
+	c := *p
+	c.Select = columns
+	return &c
+}
+
+func (p *Paginator) keyset() bool {
+	return p.Order == ""
+}
+
+func (p *Paginator) orderClause() string {
+	if !p.keyset() {
+		return p.Order
+	}
+	if p.Asc {
+		return p.TimeColumn + " ASC, " + p.IDColumn + " ASC"
+	}
+	return p.TimeColumn + " DESC, " + p.IDColumn + " DESC"
+}
+
+// afterClause 游标之后的数据
+func (p *Paginator) afterClause(key Key) (string, []interface{}) {
+	op := "<"
+	if p.Asc {
+		op = ">"
+	}
+	query := "(" + p.TimeColumn + " " + op + " ? OR (" + p.TimeColumn + " = ? AND " + p.IDColumn + " " + op + " ?))"
+	return query, []interface{}{key.CreatedAt, key.CreatedAt, key.ID}
+}
+
+// Find 按 params 查询一页，query 中不能带 Order、Offset、Limit
+// 自定义排序时 T 不需要实现 Keyed；params 带游标但 Paginator 不支持时返回 ErrInvalidCursor
+func Find[T any](query *gorm.DB, params Params, p *Paginator) (*Page[T], error) {
+	pageNum, pageSize := params.Normalize()
+	page := &Page[T]{PageSize: pageSize}
+
+	q := query.Session(&gorm.Session{})
+	if params.Cursor != "" {
+		if !p.keyset() {
+			return nil, ErrInvalidCursor
+		}
+		key, err := DecodeCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		where, args := p.afterClause(key)
+		q = q.Where(where, args...)
+	} else {
+		if err := query.Session(&gorm.Session{}).Count(&page.Total).Error; err != nil {
+			return nil, err
+		}
+		page.Page = pageNum
+		page.TotalPages = int((page.Total + int64(pageSize) - 1) / int64(pageSize))
+		q = q.Offset((page.Page - 1) * pageSize)
+	}
+
+	if p.Select != "" {
+		q = q.Select(p.Select)
+	}
+
+	// 多查一条判断是否还有下一页
+	var rows []T
+	if err := q.Order(p.orderClause()).Limit(pageSize + 1).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		page.HasNextPage = true
+	}
+	page.Data = rows
+
+	if page.HasNextPage && p.keyset() {
+		if last, ok := any(&rows[len(rows)-1]).(Keyed); ok {
+			page.NextCursor = EncodeCursor(last.PageKey())
+		}
+	}
+	return page, nil
+}
+
+// Map 转换一页数据，分页信息不变
+func Map[T, R any](page *Page[T], f func(*T) R) *Page[R] {
+	data := make([]R, 0, len(page.Data))
+	for i := range page.Data {
+		data = append(data, f(&page.Data[i]))
+	}
+	return &Page[R]{
+		Data:        data,
+		Total:       page.Total,
+		Page:        page.Page,
+		PageSize:    page.PageSize,
+		TotalPages:  page.TotalPages,
+		HasNextPage: page.HasNextPage,
+		NextCursor:  page.NextCursor,
+	}
+}
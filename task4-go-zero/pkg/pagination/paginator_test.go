@@ -0,0 +1,211 @@
+package pagination
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type item struct {
+	ID        uint
+	CreatedAt time.Time
+}
+
+func (i *item) PageKey() Key {
+	return Key{CreatedAt: i.CreatedAt, ID: i.ID}
+}
+
+func newMock(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, mock
+}
+
+// rows 生成 n 行 created_at 相同、id 从 firstID 递减的数据
+func rows(n int, firstID uint, createdAt time.Time) *sqlmock.Rows {
+	r := sqlmock.NewRows([]string{"id", "created_at"})
+	for i := 0; i < n; i++ {
+		r.AddRow(firstID-uint(i), createdAt)
+	}
+	return r
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	key := Key{CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 123, time.UTC), ID: 42}
+	got, err := DecodeCursor(EncodeCursor(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.CreatedAt.Equal(key.CreatedAt) || got.ID != key.ID {
+		t.Fatalf("DecodeCursor = %+v, want %+v", got, key)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	for _, cursor := range []string{
+		"not base64!",
+		EncodeCursor(Key{CreatedAt: time.Now()}),
+		"bm90IGpzb24",
+		"e30",
+	} {
+		if _, err := DecodeCursor(cursor); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeCursor(%q) error = %v, want ErrInvalidCursor", cursor, err)
+		}
+	}
+}
+
+func TestAfterClause(t *testing.T) {
+	key := Key{CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), ID: 7}
+	tests := []struct {
+		p    *Paginator
+		want string
+	}{
+		// 从新到旧：created_at 相同时取 id 更小的
+		{New("posts"), "(posts.created_at < ? OR (posts.created_at = ? AND posts.id < ?))"},
+		// 从旧到新：created_at 相同时取 id 更大的
+		{New("comments").Ascending(), "(comments.created_at > ? OR (comments.created_at = ? AND comments.id > ?))"},
+	}
+	for _, tt := range tests {
+		query, args := tt.p.afterClause(key)
+		if query != tt.want {
+			t.Errorf("afterClause = %q, want %q", query, tt.want)
+		}
+		if len(args) != 3 || args[0] != key.CreatedAt || args[1] != key.CreatedAt || args[2] != key.ID {
+			t.Errorf("afterClause args = %v", args)
+		}
+	}
+}
+
+func TestFindCursorOnEqualCreatedAt(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cursor := EncodeCursor(Key{CreatedAt: createdAt, ID: 10})
+	tests := []struct {
+		p     *Paginator
+		where string
+		order string
+	}{
+		{New("posts"), "posts.created_at < ? OR (posts.created_at = ? AND posts.id < ?)", "ORDER BY posts.created_at DESC, posts.id DESC"},
+		{New("posts").Ascending(), "posts.created_at > ? OR (posts.created_at = ? AND posts.id > ?)", "ORDER BY posts.created_at ASC, posts.id ASC"},
+	}
+	for _, tt := range tests {
+		db, mock := newMock(t)
+		// 游标模式不统计总数
+		mock.ExpectQuery(regexp.QuoteMeta(tt.where)+".*"+regexp.QuoteMeta(tt.order)+" LIMIT \\?").
+			WithArgs(createdAt, createdAt, 10, 3).
+			WillReturnRows(rows(2, 9, createdAt))
+
+		page, err := Find[item](db.Table("posts"), Params{PageSize: 2, Cursor: cursor}, tt.p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Data) != 2 || page.HasNextPage || page.NextCursor != "" || page.Total != 0 || page.Page != 0 {
+			t.Fatalf("page = %+v", page)
+		}
+	}
+}
+
+func TestFindInvalidCursor(t *testing.T) {
+	db, mock := newMock(t)
+	for _, cursor := range []string{"%%%", EncodeCursor(Key{CreatedAt: time.Now(), ID: 0})} {
+		if _, err := Find[item](db.Table("posts"), Params{Cursor: cursor}, New("posts")); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("Find(cursor=%q) error = %v, want ErrInvalidCursor", cursor, err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("无效游标不应查询数据库: %v", err)
+	}
+}
+
+func TestFindCursorWithOrderBy(t *testing.T) {
+	db, mock := newMock(t)
+	cursor := EncodeCursor(Key{CreatedAt: time.Now(), ID: 1})
+	p := New("posts").OrderBy("comment_count DESC")
+	if _, err := Find[item](db.Table("posts"), Params{Cursor: cursor}, p); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("自定义排序带游标 error = %v, want ErrInvalidCursor", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFindNextCursor(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		returned int
+		hasNext  bool
+	}{
+		{"最后一页", 2, false},
+		{"还有下一页", 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newMock(t)
+			mock.ExpectQuery("SELECT count\\(\\*\\) FROM `posts`").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+			mock.ExpectQuery("SELECT \\* FROM `posts` ORDER BY posts.created_at DESC, posts.id DESC LIMIT \\? OFFSET \\?").
+				WithArgs(3, 2).
+				WillReturnRows(rows(tt.returned, 8, createdAt))
+
+			page, err := Find[item](db.Table("posts"), Params{Page: 2, PageSize: 2}, New("posts"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+			if page.HasNextPage != tt.hasNext || len(page.Data) != 2 {
+				t.Fatalf("hasNextPage = %v, len = %d", page.HasNextPage, len(page.Data))
+			}
+			if page.Total != 5 || page.Page != 2 || page.TotalPages != 3 {
+				t.Fatalf("total = %d, page = %d, totalPages = %d", page.Total, page.Page, page.TotalPages)
+			}
+			if !tt.hasNext {
+				if page.NextCursor != "" {
+					t.Fatalf("没有下一页时不应返回 nextCursor: %q", page.NextCursor)
+				}
+				return
+			}
+			// 游标指向本页最后一条
+			key, err := DecodeCursor(page.NextCursor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key.ID != 7 || !key.CreatedAt.Equal(createdAt) {
+				t.Fatalf("nextCursor = %+v", key)
+			}
+		})
+	}
+}
+
+func TestFindOrderByHasNoCursor(t *testing.T) {
+	db, mock := newMock(t)
+	mock.ExpectQuery("SELECT count\\(\\*\\) FROM `posts`").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	mock.ExpectQuery("ORDER BY comment_count DESC LIMIT \\?").WillReturnRows(rows(3, 5, time.Now()))
+
+	page, err := Find[item](db.Table("posts"), Params{PageSize: 2}, New("posts").OrderBy("comment_count DESC"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !page.HasNextPage || page.NextCursor != "" {
+		t.Fatalf("自定义排序时不返回 nextCursor: %+v", page)
+	}
+}
@@ -0,0 +1,211 @@
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+)
+
+// BM25 参数和字段权重
+const (
+	bm25K1      = 1.2
+	bm25B       = 0.75
+	titleWeight = 2.0
+)
+
+type docKey struct {
+	kind string
+	id   uint
+}
+
+// posting 一个词在一篇文档各字段中出现的次数
+type posting struct {
+	title   int
+	content int
+}
+
+type memoryDoc struct {
+	doc        Document
+	titleLen   int
+	contentLen int
+	terms      []string
+}
+
+// fieldStats 同一类型文档的统计，用于计算 IDF 和平均长度
+type fieldStats struct {
+	docs       int
+	titleLen   int
+	contentLen int
+}
+
+var _ Searcher = (*MemoryIndex)(nil)
+
+// MemoryIndex 进程内的倒排索引，按 BM25 排序，重启后需要重新建立
+type MemoryIndex struct {
+	mu       sync.RWMutex
+	docs     map[docKey]*memoryDoc
+	postings map[string]map[docKey]posting
+	stats    map[string]*fieldStats
+}
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[docKey]*memoryDoc),
+		postings: make(map[string]map[docKey]posting),
+		stats:    make(map[string]*fieldStats),
+	}
+}
+
+func (m *MemoryIndex) Index(_ context.Context, docs ...Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, doc := range docs {
+		key := docKey{doc.Kind, doc.ID}
+		m.remove(key)
+
+		title := Tokenize(doc.Title)
+		content := Tokenize(doc.Content)
+		counts := make(map[string]posting)
+		for _, t := range title {
+			p := counts[t]
+			p.title++
+			counts[t] = p
+		}
+		for _, t := range content {
+			p := counts[t]
+			p.content++
+			counts[t] = p
+		}
+
+		md := &memoryDoc{doc: doc, titleLen: len(title), contentLen: len(content)}
+		for t, p := range counts {
+			if m.postings[t] == nil {
+				m.postings[t] = make(map[docKey]posting)
+			}
+			m.postings[t][key] = p
+			md.terms = append(md.terms, t)
+		}
+		m.docs[key] = md
+
+		s := m.stats[doc.Kind]
+		if s == nil {
+			s = &fieldStats{}
+			m.stats[doc.Kind] = s
+		}
+		s.docs++
+		s.titleLen += md.titleLen
+		s.contentLen += md.contentLen
+	}
+	return nil
+}
+
+func (m *MemoryIndex) Delete(_ context.Context, kind string, ids ...uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, id := range ids {
+		m.remove(docKey{kind, id})
+	}
+	return nil
+}
+
+// remove 调用方持有写锁
+func (m *MemoryIndex) remove(key docKey) {
+	md, ok := m.docs[key]
+	if !ok {
+		return
+	}
+	for _, t := range md.terms {
+		delete(m.postings[t], key)
+		if len(m.postings[t]) == 0 {
+			delete(m.postings, t)
+		}
+	}
+	delete(m.docs, key)
+
+	s := m.stats[key.kind]
+	s.docs--
+	s.titleLen -= md.titleLen
+	s.contentLen -= md.contentLen
+}
+
+func (m *MemoryIndex) Search(_ context.Context, q Query) (*Result, error) {
+	terms := uniqueTerms(Tokenize(q.Text))
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	s := m.stats[q.kind()]
+	if s == nil || s.docs == 0 {
+		return &Result{}, nil
+	}
+	avgTitle := math.Max(float64(s.titleLen)/float64(s.docs), 1)
+	avgContent := math.Max(float64(s.contentLen)/float64(s.docs), 1)
+
+	scores := make(map[docKey]float64)
+	for _, t := range terms {
+		// 只统计同类型文档，避免评论影响文章的 IDF
+		var df int
+		for key := range m.postings[t] {
+			if key.kind == q.kind() {
+				df++
+			}
+		}
+		if df == 0 {
+			continue
+		}
+		idf := math.Log(1 + (float64(s.docs)-float64(df)+0.5)/(float64(df)+0.5))
+
+		for key, p := range m.postings[t] {
+			md := m.docs[key]
+			if !q.match(&md.doc) {
+				continue
+			}
+			score := bm25(p.content, md.contentLen, avgContent)
+			if p.title > 0 {
+				score += titleWeight * bm25(p.title, md.titleLen, avgTitle)
+			}
+			scores[key] += idf * score
+		}
+	}
+
+	keys := make([]docKey, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	// 分数相同时新的在前
+	sort.Slice(keys, func(i, j int) bool {
+		if scores[keys[i]] != scores[keys[j]] {
+			return scores[keys[i]] > scores[keys[j]]
+		}
+		a, b := m.docs[keys[i]].doc, m.docs[keys[j]].doc
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID > b.ID
+	})
+
+	result := &Result{Total: int64(len(keys))}
+	from := min(max(q.Offset, 0), len(keys))
+	to := len(keys)
+	if q.Limit > 0 {
+		to = min(from+q.Limit, len(keys))
+	}
+	for _, key := range keys[from:to] {
+		result.Hits = append(result.Hits, newHit(&m.docs[key].doc, scores[key], terms))
+	}
+	return result, nil
+}
+
+func bm25(tf, length int, avg float64) float64 {
+	if tf == 0 {
+		return 0
+	}
+	f := float64(tf)
+	return f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*float64(length)/avg))
+}
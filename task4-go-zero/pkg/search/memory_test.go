@@ -0,0 +1,198 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var day = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+func newIndex(t *testing.T, docs ...Document) *MemoryIndex {
+	t.Helper()
+	m := NewMemoryIndex()
+	if err := m.Index(context.Background(), docs...); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func search(t *testing.T, m *MemoryIndex, q Query) []uint {
+	t.Helper()
+	result, err := m.Search(context.Background(), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]uint, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ID)
+	}
+	if int(result.Total) < len(ids) {
+		t.Fatalf("total = %d, hits = %d", result.Total, len(ids))
+	}
+	return ids
+}
+
+func TestSearchTitleOutranksContent(t *testing.T) {
+	m := newIndex(t,
+		Document{Kind: KindPost, ID: 1, Title: "入门笔记", Content: "golang 并发模型", CreatedAt: day.Add(2 * time.Hour)},
+		Document{Kind: KindPost, ID: 2, Title: "golang 入门", Content: "变量和函数", CreatedAt: day},
+		Document{Kind: KindPost, ID: 3, Title: "rust", Content: "所有权", CreatedAt: day},
+		// 评论不参与文章搜索
+		Document{Kind: KindComment, ID: 1, PostID: 3, Content: "golang golang golang", CreatedAt: day},
+	)
+
+	// 标题命中排在正文命中之前，即使正文命中的文章更新
+	if got := search(t, m, Query{Text: "Golang"}); !reflect.DeepEqual(got, []uint{2, 1}) {
+		t.Fatalf("Search(golang) = %v, want [2 1]", got)
+	}
+	// 命中的词越多分数越高
+	if got := search(t, m, Query{Text: "golang 并发"}); !reflect.DeepEqual(got, []uint{1, 2}) {
+		t.Fatalf("Search(golang 并发) = %v, want [1 2]", got)
+	}
+	if got := search(t, m, Query{Text: "golang", Kind: KindComment}); !reflect.DeepEqual(got, []uint{1}) {
+		t.Fatalf("Search(comment) = %v, want [1]", got)
+	}
+	if _, err := m.Search(context.Background(), Query{Text: " ,. "}); err != ErrEmptyQuery {
+		t.Fatalf("空查询 error = %v, want ErrEmptyQuery", err)
+	}
+}
+
+func TestSearchTieBreak(t *testing.T) {
+	m := newIndex(t,
+		Document{Kind: KindPost, ID: 1, Title: "go", CreatedAt: day},
+		Document{Kind: KindPost, ID: 2, Title: "go", CreatedAt: day.Add(time.Hour)},
+		Document{Kind: KindPost, ID: 3, Title: "go", CreatedAt: day},
+	)
+	// 分数相同时新的在前，时间相同时 ID 大的在前
+	if got := search(t, m, Query{Text: "go"}); !reflect.DeepEqual(got, []uint{2, 3, 1}) {
+		t.Fatalf("Search = %v, want [2 3 1]", got)
+	}
+	if got := search(t, m, Query{Text: "go", Offset: 1, Limit: 1}); !reflect.DeepEqual(got, []uint{3}) {
+		t.Fatalf("Search(offset 1, limit 1) = %v, want [3]", got)
+	}
+}
+
+func TestSearchFilters(t *testing.T) {
+	m := newIndex(t,
+		Document{Kind: KindPost, ID: 1, UserID: 1, Title: "go", Tags: []string{"go", "web"}, CreatedAt: day},
+		Document{Kind: KindPost, ID: 2, UserID: 2, Title: "go", Tags: []string{"go"}, CreatedAt: day.Add(24 * time.Hour)},
+		Document{Kind: KindPost, ID: 3, UserID: 1, Title: "go", CreatedAt: day.Add(48 * time.Hour)},
+	)
+	tests := []struct {
+		name string
+		q    Query
+		want []uint
+	}{
+		{"作者", Query{UserID: 1}, []uint{3, 1}},
+		{"标签", Query{Tag: "go"}, []uint{2, 1}},
+		{"作者和标签", Query{UserID: 1, Tag: "web"}, []uint{1}},
+		// 包含 From，不包含 To
+		{"时间范围", Query{From: day.Add(24 * time.Hour), To: day.Add(48 * time.Hour)}, []uint{2}},
+		{"开始时间", Query{From: day.Add(24 * time.Hour)}, []uint{3, 2}},
+		{"结束时间", Query{To: day.Add(24 * time.Hour)}, []uint{1}},
+		{"没有结果", Query{UserID: 2, Tag: "web"}, []uint{}},
+	}
+	for _, tt := range tests {
+		tt.q.Text = "go"
+		if got := search(t, m, tt.q); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Search = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIndexDeleteKeepsStats(t *testing.T) {
+	ctx := context.Background()
+	m := newIndex(t,
+		Document{Kind: KindPost, ID: 1, Title: "go 入门", Content: "变量 函数 接口"},
+		Document{Kind: KindPost, ID: 2, Title: "rust", Content: "所有权"},
+		Document{Kind: KindComment, ID: 1, Content: "写得好"},
+	)
+	// 更新文档替换旧的统计和倒排
+	if err := m.Index(ctx, Document{Kind: KindPost, ID: 1, Title: "go", Content: "接口"}); err != nil {
+		t.Fatal(err)
+	}
+	// 删除不存在的文档不影响统计
+	if err := m.Delete(ctx, KindPost, 2, 99); err != nil {
+		t.Fatal(err)
+	}
+
+	want := newIndex(t,
+		Document{Kind: KindPost, ID: 1, Title: "go", Content: "接口"},
+		Document{Kind: KindComment, ID: 1, Content: "写得好"},
+	)
+	if !reflect.DeepEqual(m.stats, want.stats) {
+		t.Fatalf("stats = %+v / %+v, want %+v / %+v", *m.stats[KindPost], *m.stats[KindComment], *want.stats[KindPost], *want.stats[KindComment])
+	}
+	if !reflect.DeepEqual(m.postings, want.postings) {
+		t.Fatalf("postings = %v, want %v", m.postings, want.postings)
+	}
+	if got := search(t, m, Query{Text: "rust 函数"}); len(got) != 0 {
+		t.Fatalf("已删除和已更新的内容仍能搜到: %v", got)
+	}
+
+	// 全部删除后统计归零
+	if err := m.Delete(ctx, KindPost, 1); err != nil {
+		t.Fatal(err)
+	}
+	if s := m.stats[KindPost]; *s != (fieldStats{}) {
+		t.Fatalf("stats = %+v, want zero", *s)
+	}
+	if got := search(t, m, Query{Text: "go"}); len(got) != 0 {
+		t.Fatalf("Search = %v, want none", got)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text     string
+		terms    []string
+		maxRunes int
+		want     string
+	}{
+		{"Go 语言", []string{"go"}, 0, "<em>Go</em> 语言"},
+		// 英文整词匹配
+		{"golang and go", []string{"go"}, 0, "golang and <em>go</em>"},
+		// 汉字按两个字切分的词重叠时合并
+		{"学习并发编程", Tokenize("并发编程"), 0, "学习<em>并发编程</em>"},
+		// 其余文本做 HTML 转义，不能注入标签
+		{`<script>alert("go")</script>`, []string{"go"}, 0, `&lt;script&gt;alert(&#34;<em>go</em>&#34;)&lt;/script&gt;`},
+		{"a & b <em>", []string{"em"}, 0, "a &amp; b &lt;<em>em</em>&gt;"},
+		{"没有命中", []string{"go"}, 0, "没有命中"},
+	}
+	for _, tt := range tests {
+		if got := Highlight(tt.text, tt.terms, tt.maxRunes); got != tt.want {
+			t.Errorf("Highlight(%q, %v) = %q, want %q", tt.text, tt.terms, got, tt.want)
+		}
+	}
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	text := strings.Repeat("a", 50) + " go " + strings.Repeat("b", 50)
+
+	// 命中位置前保留四分之一的长度，两端截断处加省略号
+	got := Highlight(text, []string{"go"}, 20)
+	want := "…aaaa <em>go</em> " + strings.Repeat("b", 12) + "…"
+	if got != want {
+		t.Fatalf("Highlight = %q, want %q", got, want)
+	}
+
+	// 没有命中时从开头截取
+	if got := Highlight(text, []string{"rust"}, 10); got != strings.Repeat("a", 10)+"…" {
+		t.Fatalf("Highlight without hit = %q", got)
+	}
+	// 命中在末尾时截取最后 maxRunes 个字符
+	if got := Highlight("abcdefghij go", []string{"go"}, 8); got != "…fghij <em>go</em>" {
+		t.Fatalf("Highlight at end = %q", got)
+	}
+	// 不超过长度时不截断
+	if got := Highlight("go", []string{"go"}, 20); got != "<em>go</em>" {
+		t.Fatalf("Highlight short = %q", got)
+	}
+	// 截断按字符计算，不会切开汉字
+	if got := Highlight(strings.Repeat("文", 10), nil, 4); got != "文文文文…" {
+		t.Fatalf("Highlight han = %q", got)
+	}
+}
@@ -0,0 +1,122 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var _ Searcher = (*MySQL)(nil)
+
+// MySQL 使用 MySQL FULLTEXT 索引搜索 posts、comments 表，使用 ngram 分词以支持中文
+// 表结构由调用方维护，这里只读取 id、user_id、post_id、title、content、created_at、deleted_at 列，按标签过滤时读取 post_tags、tags 表
+type MySQL struct {
+	db *gorm.DB
+}
+
+func NewMySQL(db *gorm.DB) *MySQL {
+	return &MySQL{db: db}
+}
+
+// fulltextIndexes 每张表需要的 FULLTEXT 索引
+var fulltextIndexes = []struct {
+	table, name, columns string
+}{
+	{"posts", "ft_posts_title_content", "title, content"},
+	{"comments", "ft_comments_content", "content"},
+}
+
+// EnsureIndexes 创建缺少的 FULLTEXT 索引，表需要已经存在
+func (s *MySQL) EnsureIndexes(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+	for _, idx := range fulltextIndexes {
+		var n int64
+		if err := db.Raw(
+			"SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+			idx.table, idx.name,
+		).Scan(&n).Error; err != nil {
+			return err
+		}
+		if n > 0 {
+			continue
+		}
+		if err := db.Exec("CREATE FULLTEXT INDEX " + idx.name + " ON " + idx.table + " (" + idx.columns + ") WITH PARSER ngram").Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Index 数据以数据库为准，不需要单独建立索引
+func (s *MySQL) Index(context.Context, ...Document) error {
+	return nil
+}
+
+func (s *MySQL) Delete(context.Context, string, ...uint) error {
+	return nil
+}
+
+type mysqlRow struct {
+	ID        uint
+	PostID    uint
+	UserID    uint
+	Title     string
+	Content   string
+	CreatedAt time.Time
+	Score     float64
+}
+
+func (s *MySQL) Search(ctx context.Context, q Query) (*Result, error) {
+	terms := uniqueTerms(Tokenize(q.Text))
+	if len(terms) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	table, match, columns := "posts", "MATCH(title, content) AGAINST (? IN NATURAL LANGUAGE MODE)", "id, id AS post_id, user_id, title, content, created_at"
+	if q.kind() == KindComment {
+		table, match, columns = "comments", "MATCH(content) AGAINST (? IN NATURAL LANGUAGE MODE)", "id, post_id, user_id, '' AS title, content, created_at"
+	}
+
+	query := s.db.WithContext(ctx).Table(table).Where("deleted_at IS NULL").Where(match, q.Text)
+	if q.UserID != 0 {
+		query = query.Where("user_id = ?", q.UserID)
+	}
+	if q.Tag != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE post_tags.post_id = "+table+".id AND tags.name = ?)", q.Tag)
+	}
+	if !q.From.IsZero() {
+		query = query.Where("created_at >= ?", q.From)
+	}
+	if !q.To.IsZero() {
+		query = query.Where("created_at < ?", q.To)
+	}
+
+	result := &Result{}
+	if err := query.Session(&gorm.Session{}).Count(&result.Total).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []mysqlRow
+	query = query.Select(columns+", "+match+" AS score", q.Text).Order("score DESC, created_at DESC, id DESC").Offset(q.Offset)
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		doc := Document{
+			Kind:      q.kind(),
+			ID:        row.ID,
+			PostID:    row.PostID,
+			UserID:    row.UserID,
+			Title:     row.Title,
+			Content:   row.Content,
+			CreatedAt: row.CreatedAt,
+		}
+		result.Hits = append(result.Hits, newHit(&doc, row.Score, terms))
+	}
+	return result, nil
+}
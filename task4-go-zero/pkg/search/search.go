@@ -0,0 +1,128 @@
+package search
+
+/**
+全文搜索
+    Searcher 是搜索后端的统一接口，默认使用进程内的倒排索引，不依赖外部服务
+    也可以使用 MySQL FULLTEXT 索引，此时数据以数据库为准，Index、Delete 不做任何事
+    文章按标题和正文排序，标题的权重更高；评论按内容排序
+    返回的标题和摘要中命中的词用 <em></em> 包裹，其余文本已做 HTML 转义
+*/
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// 文档类型
+const (
+	KindPost    = "post"
+	KindComment = "comment"
+)
+
+var ErrEmptyQuery = errors.New("search: empty query")
+
+// Document 被索引的一篇文章或一条评论
+type Document struct {
+	Kind string
+	ID   uint
+	// 评论所在文章，文章为自身ID
+	PostID  uint
+	UserID  uint
+	Title   string
+	Content string
+	// 标签名，只有文章有
+	Tags      []string
+	CreatedAt time.Time
+}
+
+// Query 搜索条件，零值的过滤条件不生效
+type Query struct {
+	Text string
+	// 为空时只搜索文章
+	Kind   string
+	UserID uint
+	// 标签，只对文章生效
+	Tag string
+	// 创建时间范围，包含 From，不包含 To
+	From   time.Time
+	To     time.Time
+	Offset int
+	Limit  int
+}
+
+// Hit 一条搜索结果
+type Hit struct {
+	Kind      string
+	ID        uint
+	PostID    uint
+	UserID    uint
+	CreatedAt time.Time
+	Score     float64
+	// 带高亮的完整标题，评论为空
+	Title string
+	// 带高亮的正文片段
+	Snippet string
+}
+
+// Result 一页搜索结果，Total 为符合条件的总数
+type Result struct {
+	Total int64
+	Hits  []Hit
+}
+
+type Searcher interface {
+	// Index 新增或更新文档
+	Index(ctx context.Context, docs ...Document) error
+	// Delete 删除文档，不存在的文档忽略
+	Delete(ctx context.Context, kind string, ids ...uint) error
+	Search(ctx context.Context, q Query) (*Result, error)
+}
+
+// SnippetLength 摘要的最大字符数
+const SnippetLength = 120
+
+func (q *Query) kind() string {
+	if q.Kind == "" {
+		return KindPost
+	}
+	return q.Kind
+}
+
+// match 是否满足过滤条件
+func (q *Query) match(doc *Document) bool {
+	if doc.Kind != q.kind() {
+		return false
+	}
+	if q.UserID != 0 && doc.UserID != q.UserID {
+		return false
+	}
+	if q.Tag != "" && !slices.Contains(doc.Tags, q.Tag) {
+		return false
+	}
+	if !q.From.IsZero() && doc.CreatedAt.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !doc.CreatedAt.Before(q.To) {
+		return false
+	}
+	return true
+}
+
+// newHit 生成带高亮的结果
+func newHit(doc *Document, score float64, terms []string) Hit {
+	hit := Hit{
+		Kind:      doc.Kind,
+		ID:        doc.ID,
+		PostID:    doc.PostID,
+		UserID:    doc.UserID,
+		CreatedAt: doc.CreatedAt,
+		Score:     score,
+		Snippet:   Highlight(doc.Content, terms, SnippetLength),
+	}
+	if doc.Title != "" {
+		hit.Title = Highlight(doc.Title, terms, 0)
+	}
+	return hit
+}
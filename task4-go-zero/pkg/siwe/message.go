@@ -0,0 +1,210 @@
+package siwe
+
+/**
+Sign-In With Ethereum (EIP-4361)
+    钱包对一段固定格式的文本做 personal_sign 签名，服务端用 ecrecover 恢复出签名地址，和消息中的地址一致即证明持有该钱包
+    消息中的 domain、nonce、时间范围由服务端校验，nonce 只能使用一次，防止签名被重放
+    只依赖本地计算，不需要访问链
+*/
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// Version EIP-4361 目前只有版本 1
+	Version = "1"
+	// nonceLength 生成的 nonce 长度，规范要求至少 8 个字母或数字
+	nonceLength = 16
+
+	preambleSuffix = " wants you to sign in with your Ethereum account:"
+)
+
+var (
+	ErrInvalidMessage = errors.New("siwe: invalid message")
+	ErrInvalidAddress = errors.New("siwe: invalid address")
+)
+
+// Message 一条 SIWE 消息，可选字段为空时不出现在文本中
+type Message struct {
+	// 请求签名的站点，如 example.com 或 localhost:8888
+	Domain string
+	// EIP-55 校验和格式的地址
+	Address   string
+	Statement string
+	URI       string
+	Version   string
+	ChainID   int64
+	Nonce     string
+	IssuedAt  time.Time
+	// 可选
+	ExpirationTime time.Time
+	NotBefore      time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// NewNonce 生成随机的字母数字 nonce
+func NewNonce() (string, error) {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, nonceLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		// 256 不是 62 的倍数，取模有很小的偏差，对 nonce 没有影响
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b), nil
+}
+
+// String 按 EIP-4361 的格式生成待签名的文本
+func (m *Message) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + preambleSuffix + "\n")
+	b.WriteString(m.Address + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("URI: " + m.URI + "\n")
+	b.WriteString("Version: " + m.Version + "\n")
+	b.WriteString("Chain ID: " + strconv.FormatInt(m.ChainID, 10) + "\n")
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + m.IssuedAt.UTC().Format(time.RFC3339))
+	if !m.ExpirationTime.IsZero() {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if !m.NotBefore.IsZero() {
+		b.WriteString("\nNot Before: " + m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, r := range m.Resources {
+			b.WriteString("\n- " + r)
+		}
+	}
+	return b.String()
+}
+
+// ParseMessage 解析钱包签名的文本，只检查格式，不检查内容是否有效
+func ParseMessage(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	next := func() (string, bool) {
+		if len(lines) == 0 {
+			return "", false
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, true
+	}
+
+	m := &Message{}
+	line, _ := next()
+	domain, ok := strings.CutSuffix(line, preambleSuffix)
+	if !ok || domain == "" {
+		return nil, fmt.Errorf("%w: preamble", ErrInvalidMessage)
+	}
+	// 规范允许在 domain 前带上 scheme
+	if i := strings.Index(domain, "://"); i >= 0 {
+		domain = domain[i+3:]
+	}
+	m.Domain = domain
+
+	if m.Address, ok = next(); !ok || !IsChecksumAddress(m.Address) {
+		return nil, ErrInvalidAddress
+	}
+	if line, ok = next(); !ok || line != "" {
+		return nil, fmt.Errorf("%w: missing blank line after address", ErrInvalidMessage)
+	}
+
+	// statement 可选，有 statement 时后面跟一个空行
+	if line, ok = next(); !ok {
+		return nil, fmt.Errorf("%w: missing URI", ErrInvalidMessage)
+	}
+	if line != "" {
+		m.Statement = line
+		if line, ok = next(); !ok || line != "" {
+			return nil, fmt.Errorf("%w: missing blank line after statement", ErrInvalidMessage)
+		}
+	}
+
+	var err error
+	field := func(name string, required bool) (string, error) {
+		if len(lines) == 0 || !strings.HasPrefix(lines[0], name+": ") {
+			if required {
+				return "", fmt.Errorf("%w: missing %s", ErrInvalidMessage, name)
+			}
+			return "", nil
+		}
+		line, _ := next()
+		return strings.TrimPrefix(line, name+": "), nil
+	}
+	timeField := func(name string, required bool) (time.Time, error) {
+		s, err := field(name, required)
+		if err != nil || s == "" {
+			return time.Time{}, err
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidMessage, name)
+		}
+		return t, nil
+	}
+
+	if m.URI, err = field("URI", true); err != nil {
+		return nil, err
+	}
+	if m.Version, err = field("Version", true); err != nil {
+		return nil, err
+	}
+	chainID, err := field("Chain ID", true)
+	if err != nil {
+		return nil, err
+	}
+	if m.ChainID, err = strconv.ParseInt(chainID, 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: Chain ID", ErrInvalidMessage)
+	}
+	if m.Nonce, err = field("Nonce", true); err != nil {
+		return nil, err
+	}
+	if m.IssuedAt, err = timeField("Issued At", true); err != nil {
+		return nil, err
+	}
+	if m.ExpirationTime, err = timeField("Expiration Time", false); err != nil {
+		return nil, err
+	}
+	if m.NotBefore, err = timeField("Not Before", false); err != nil {
+		return nil, err
+	}
+	if m.RequestID, err = field("Request ID", false); err != nil {
+		return nil, err
+	}
+	if len(lines) > 0 && lines[0] == "Resources:" {
+		next()
+		for len(lines) > 0 && strings.HasPrefix(lines[0], "- ") {
+			line, _ := next()
+			m.Resources = append(m.Resources, strings.TrimPrefix(line, "- "))
+		}
+	}
+	if len(lines) > 0 {
+		return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, lines[0])
+	}
+
+	return m, nil
+}
+
+// IsChecksumAddress 是否为 EIP-55 校验和格式的地址
+func IsChecksumAddress(address string) bool {
+	return common.IsHexAddress(address) && strings.HasPrefix(address, "0x") && common.HexToAddress(address).Hex() == address
+}
@@ -0,0 +1,128 @@
+package siwe
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+func testMessage() *Message {
+	return &Message{
+		Domain:    "example.com",
+		Address:   testAddress,
+		Statement: "Sign in to task4",
+		URI:       "https://example.com/login",
+		Version:   Version,
+		ChainID:   1,
+		Nonce:     "32891756abcdEFGH",
+		IssuedAt:  time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	full := testMessage()
+	full.ExpirationTime = full.IssuedAt.Add(5 * time.Minute)
+	full.NotBefore = full.IssuedAt.Add(-time.Minute)
+	full.RequestID = "req-1"
+	full.Resources = []string{"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/", "https://example.com/my-web2-claim.json"}
+
+	minimal := testMessage()
+	minimal.Statement = ""
+
+	for _, m := range []*Message{testMessage(), full, minimal} {
+		text := m.String()
+		got, err := ParseMessage(text)
+		if err != nil {
+			t.Fatalf("ParseMessage error: %v\n%s", err, text)
+		}
+		if !reflect.DeepEqual(got, m) {
+			t.Fatalf("ParseMessage = %+v, want %+v", got, m)
+		}
+		if got.String() != text {
+			t.Fatalf("String 不一致:\n%s\n%s", got.String(), text)
+		}
+	}
+}
+
+func TestMessageString(t *testing.T) {
+	want := "example.com wants you to sign in with your Ethereum account:\n" +
+		testAddress + "\n\n" +
+		"Sign in to task4\n\n" +
+		"URI: https://example.com/login\n" +
+		"Version: 1\n" +
+		"Chain ID: 1\n" +
+		"Nonce: 32891756abcdEFGH\n" +
+		"Issued At: 2024-05-01T12:00:00Z"
+	if got := testMessage().String(); got != want {
+		t.Fatalf("String =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestParseMessage(t *testing.T) {
+	text := testMessage().String()
+
+	// 钱包可能使用 \r\n，domain 前可以带 scheme
+	m, err := ParseMessage(strings.ReplaceAll("https://"+text, "\n", "\r\n"))
+	if err != nil || m.Domain != "example.com" {
+		t.Fatalf("ParseMessage = %+v, %v", m, err)
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want error
+	}{
+		{"缺少开头", strings.Replace(text, preambleSuffix, " wants you to sign in", 1), ErrInvalidMessage},
+		{"地址全小写", strings.Replace(text, testAddress, strings.ToLower(testAddress), 1), ErrInvalidAddress},
+		{"地址校验和错误", strings.Replace(text, testAddress, "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", 1), ErrInvalidAddress},
+		{"地址不是十六进制", strings.Replace(text, testAddress, "example.eth", 1), ErrInvalidAddress},
+		{"缺少 Nonce", strings.Replace(text, "Nonce: 32891756abcdEFGH\n", "", 1), ErrInvalidMessage},
+		{"Chain ID 不是数字", strings.Replace(text, "Chain ID: 1", "Chain ID: one", 1), ErrInvalidMessage},
+		{"时间格式错误", strings.Replace(text, "2024-05-01T12:00:00Z", "2024-05-01 12:00:00", 1), ErrInvalidMessage},
+		{"多余的行", text + "\nfoo", ErrInvalidMessage},
+		{"空消息", "", ErrInvalidMessage},
+	}
+	for _, tt := range tests {
+		if _, err := ParseMessage(tt.text); !errors.Is(err, tt.want) {
+			t.Errorf("%s: ParseMessage error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestIsChecksumAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		want    bool
+	}{
+		{testAddress, true},
+		{strings.ToLower(testAddress), false},
+		{"0x" + strings.ToUpper(testAddress[2:]), false},
+		{testAddress[2:], false},
+		{testAddress[:41], false},
+	}
+	for _, tt := range tests {
+		if got := IsChecksumAddress(tt.address); got != tt.want {
+			t.Errorf("IsChecksumAddress(%q) = %v, want %v", tt.address, got, tt.want)
+		}
+	}
+}
+
+func TestNewNonce(t *testing.T) {
+	a, err := NewNonce()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := NewNonce()
+	if len(a) != nonceLength || a == b {
+		t.Fatalf("NewNonce = %q, %q", a, b)
+	}
+	m := testMessage()
+	m.Nonce = a
+	if _, err := ParseMessage(m.String()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,94 @@
+package siwe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrInvalidSignature = errors.New("siwe: invalid signature")
+	ErrDomainMismatch   = errors.New("siwe: domain mismatch")
+	ErrChainMismatch    = errors.New("siwe: chain id mismatch")
+	ErrExpired          = errors.New("siwe: message expired")
+	ErrNotYetValid      = errors.New("siwe: message not yet valid")
+)
+
+// VerifyOptions 服务端期望的消息内容
+type VerifyOptions struct {
+	Domain  string
+	ChainID int64
+	// 签名时间和当前时间的最大偏差，为 0 时不检查 Issued At
+	MaxAge time.Duration
+	Now    time.Time
+}
+
+// Verify 检查签名和消息内容，返回签名的地址
+// nonce 是否由服务端签发、是否已使用由调用方检查
+func (m *Message) Verify(text, signature string, opts VerifyOptions) (common.Address, error) {
+	if m.Domain != opts.Domain {
+		return common.Address{}, ErrDomainMismatch
+	}
+	if m.Version != Version {
+		return common.Address{}, fmt.Errorf("%w: version", ErrInvalidMessage)
+	}
+	if opts.ChainID != 0 && m.ChainID != opts.ChainID {
+		return common.Address{}, ErrChainMismatch
+	}
+	if len(m.Nonce) < 8 || strings.IndexFunc(m.Nonce, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	}) >= 0 {
+		return common.Address{}, fmt.Errorf("%w: nonce", ErrInvalidMessage)
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return common.Address{}, ErrExpired
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return common.Address{}, ErrNotYetValid
+	}
+	if opts.MaxAge > 0 && (now.Sub(m.IssuedAt) > opts.MaxAge || m.IssuedAt.Sub(now) > opts.MaxAge) {
+		return common.Address{}, ErrExpired
+	}
+
+	address, err := RecoverAddress(text, signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if address.Hex() != m.Address {
+		return common.Address{}, ErrInvalidSignature
+	}
+	return address, nil
+}
+
+// RecoverAddress 从 personal_sign (EIP-191) 签名中恢复签名地址
+func RecoverAddress(text, signature string) (common.Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidSignature
+	}
+	// 钱包返回的 v 为 27 或 28，ecrecover 需要 0 或 1
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.SigToPub(TextHash(text), sig)
+	if err != nil {
+		return common.Address{}, ErrInvalidSignature
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// TextHash personal_sign 签名的消息哈希
+func TextHash(text string) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(text), text)))
+}
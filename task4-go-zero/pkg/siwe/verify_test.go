@@ -0,0 +1,130 @@
+package siwe
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// sign 模拟钱包的 personal_sign，返回的 v 为 27 或 28
+func sign(t *testing.T, key *ecdsa.PrivateKey, text string) []byte {
+	t.Helper()
+	sig, err := crypto.Sign(TextHash(text), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig
+}
+
+// signedMessage 用新生成的私钥签名的消息
+func signedMessage(t *testing.T) (*Message, string, string, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := testMessage()
+	m.Address = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	m.IssuedAt = time.Now().UTC().Truncate(time.Second)
+	text := m.String()
+	return m, text, hexutil.Encode(sign(t, key, text)), key
+}
+
+func TestVerify(t *testing.T) {
+	m, text, signature, key := signedMessage(t)
+	parsed, err := ParseMessage(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, err := parsed.Verify(text, signature, VerifyOptions{Domain: "example.com", ChainID: 1, MaxAge: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != crypto.PubkeyToAddress(key.PublicKey) || address.Hex() != m.Address {
+		t.Fatalf("Verify = %s, want %s", address.Hex(), m.Address)
+	}
+}
+
+// TestRecoverAddressV 钱包返回 v=27/28，也接受 ecrecover 使用的 0/1
+func TestRecoverAddressV(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := crypto.PubkeyToAddress(key.PublicKey)
+
+	seen := map[byte]bool{}
+	for i := 0; i < 64 && len(seen) < 2; i++ {
+		m := testMessage()
+		m.Address = want.Hex()
+		m.Nonce = m.Nonce[:8] + hexutil.EncodeUint64(uint64(i))[2:]
+		text := m.String()
+		sig := sign(t, key, text)
+		v := sig[crypto.RecoveryIDOffset]
+		seen[v] = true
+
+		for _, sig := range [][]byte{sig, append(sig[:crypto.RecoveryIDOffset:crypto.RecoveryIDOffset], v-27)} {
+			got, err := RecoverAddress(text, hexutil.Encode(sig))
+			if err != nil || got != want {
+				t.Fatalf("RecoverAddress(v=%d) = %s, %v, want %s", sig[crypto.RecoveryIDOffset], got.Hex(), err, want.Hex())
+			}
+		}
+	}
+	if !seen[27] || !seen[28] {
+		t.Fatalf("没有同时覆盖 v=27 和 v=28: %v", seen)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	_, text, signature, _ := signedMessage(t)
+	_, _, otherSignature, _ := signedMessage(t)
+	opts := VerifyOptions{Domain: "example.com", ChainID: 1, MaxAge: time.Minute}
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		modify    func(m *Message, opts *VerifyOptions)
+		signature string
+		want      error
+	}{
+		{"domain 不一致", func(m *Message, opts *VerifyOptions) { opts.Domain = "evil.com" }, signature, ErrDomainMismatch},
+		{"chain id 不一致", func(m *Message, opts *VerifyOptions) { opts.ChainID = 5 }, signature, ErrChainMismatch},
+		{"版本错误", func(m *Message, opts *VerifyOptions) { m.Version = "2" }, signature, ErrInvalidMessage},
+		{"nonce 太短", func(m *Message, opts *VerifyOptions) { m.Nonce = "abc" }, signature, ErrInvalidMessage},
+		{"nonce 含符号", func(m *Message, opts *VerifyOptions) { m.Nonce = "abcd-efgh" }, signature, ErrInvalidMessage},
+		{"已过期", func(m *Message, opts *VerifyOptions) { m.ExpirationTime = now }, signature, ErrExpired},
+		{"还未生效", func(m *Message, opts *VerifyOptions) { m.NotBefore = now.Add(time.Minute) }, signature, ErrNotYetValid},
+		{"签发时间太早", func(m *Message, opts *VerifyOptions) { m.IssuedAt = now.Add(-2 * time.Minute) }, signature, ErrExpired},
+		{"签发时间在未来", func(m *Message, opts *VerifyOptions) { m.IssuedAt = now.Add(2 * time.Minute) }, signature, ErrExpired},
+		{"其他地址的签名", nil, otherSignature, ErrInvalidSignature},
+		{"消息地址不是校验和格式", func(m *Message, opts *VerifyOptions) { m.Address = strings.ToLower(m.Address) }, signature, ErrInvalidSignature},
+		{"签名长度错误", nil, signature[:len(signature)-2], ErrInvalidSignature},
+		{"签名不是十六进制", nil, "signature", ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		m, err := ParseMessage(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o := opts
+		if tt.modify != nil {
+			tt.modify(m, &o)
+		}
+		if _, err := m.Verify(text, tt.signature, o); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Verify error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// 不要求 Issued At 时只检查其他字段
+	m, _ := ParseMessage(text)
+	m.IssuedAt = now.Add(-time.Hour)
+	if _, err := m.Verify(text, signature, VerifyOptions{Domain: "example.com", ChainID: 1}); err != nil {
+		t.Fatalf("MaxAge 为 0 时 error = %v", err)
+	}
+}
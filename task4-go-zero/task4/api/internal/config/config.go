@@ -1,13 +1,19 @@
 package config
 
 import (
+	"time"
+
 	"github.com/zeromicro/go-zero/rest"
 )
 
 type Config struct {
 	rest.RestConf
-	Mysql MysqlConfig
-	Auth  AuthConfig
+	Mysql   MysqlConfig
+	Auth    AuthConfig
+	Comment CommentConfig `json:",optional"`
+	Trash   TrashConfig   `json:",optional"`
+	Search  SearchConfig  `json:",optional"`
+	Siwe    SiweConfig    `json:",optional"`
 }
 
 type MysqlConfig struct {
@@ -15,6 +21,38 @@ type MysqlConfig struct {
 }
 
 type AuthConfig struct {
-	JwtSecret   string
+	JwtSecret string
+	// access token 的有效期，单位秒
 	TokenExpiry int
+	// refresh token 的有效期，每次刷新重新计算
+	RefreshTokenExpiry time.Duration `json:",default=720h"`
+	// 启动时设为管理员的已有用户ID，用于创建第一个管理员，之后通过 /admin/user/role 管理
+	AdminUserIDs []uint `json:",optional"`
+}
+
+type CommentConfig struct {
+	// 回复的最大层数，顶层评论为第 0 层
+	MaxDepth int `json:",default=3"`
+}
+
+type TrashConfig struct {
+	// 回收站中的文章和评论保留多久后彻底删除
+	Retention time.Duration `json:",default=720h"`
+	// 清理任务的执行间隔
+	PurgeInterval time.Duration `json:",default=1h"`
+}
+
+type SearchConfig struct {
+	// memory 为进程内索引，启动时从数据库加载；mysql 使用 FULLTEXT 索引
+	Backend string `json:",default=memory,options=memory|mysql"`
+}
+
+type SiweConfig struct {
+	// 签名消息中的 domain 必须和这里一致，通常是前端页面的 host[:port]
+	Domain string `json:",default=localhost:8888"`
+	// 签名消息中的 URI，为空时使用 http://{Domain}
+	URI     string `json:",optional"`
+	ChainID int64  `json:",default=1"`
+	// nonce 的有效期，也是签名时间和当前时间允许的最大偏差
+	NonceExpiry time.Duration `json:",default=5m"`
 }
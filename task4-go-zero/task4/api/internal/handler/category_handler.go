@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/task4/api/internal/logic"
+	"task4-go-zero/task4/api/internal/svc"
+)
+
+func CategoryCreateHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CategoryCreateReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewCategoryLogic(r.Context(), svcCtx)
+		resp, err := l.Create(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "创建分类成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func CategoryPageHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CategoryPageReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewCategoryLogic(r.Context(), svcCtx)
+		resp, err := l.Page(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询分类成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func CategoryPostsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CategoryPostsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewCategoryLogic(r.Context(), svcCtx)
+		resp, err := l.Posts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询文章成功",
+				"data":    resp,
+			})
+		}
+	}
+}
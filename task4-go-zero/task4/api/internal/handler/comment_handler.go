@@ -60,3 +60,119 @@ func CommentListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 	}
 }
+
+func CommentEditHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CommentEditReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 获取用户ID
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewCommentLogic(r.Context(), svcCtx)
+		err := l.Edit(&req, userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "更新评论成功",
+				"data":    nil,
+			})
+		}
+	}
+}
+
+func CommentDeleteHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CommentDeleteReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 获取用户ID
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewCommentLogic(r.Context(), svcCtx)
+		err := l.Delete(&req, userID, middleware.UserRole(r.Context()))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "删除评论成功",
+				"data":    nil,
+			})
+		}
+	}
+}
+
+func CommentTrashHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TrashPageReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 获取用户ID
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewCommentLogic(r.Context(), svcCtx)
+		resp, err := l.Trash(&req, userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询回收站成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func CommentRestoreHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TrashRestoreReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 获取用户ID
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewCommentLogic(r.Context(), svcCtx)
+		err := l.Restore(&req, userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "恢复评论成功",
+				"data":    nil,
+			})
+		}
+	}
+}
@@ -143,7 +143,7 @@ func PostDeleteHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 
 		l := logic.NewPostLogic(r.Context(), svcCtx)
-		err := l.Delete(&req, userID)
+		err := l.Delete(&req, userID, middleware.UserRole(r.Context()))
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
@@ -155,3 +155,127 @@ func PostDeleteHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 	}
 }
+
+func PostFeedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PostFeedReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewPostLogic(r.Context(), svcCtx)
+		resp, err := l.Feed(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询文章成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func AuthorPostsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AuthorPostsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewPostLogic(r.Context(), svcCtx)
+		resp, err := l.AuthorPosts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询文章成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func PostPublicDetailHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PostPublicReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewPostLogic(r.Context(), svcCtx)
+		resp, err := l.PublicDetail(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询文章成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func PostTrashHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TrashPageReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 获取用户ID
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, fmt.Errorf("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewPostLogic(r.Context(), svcCtx)
+		resp, err := l.Trash(&req, userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询回收站成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func PostRestoreHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TrashRestoreReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 获取用户ID
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, fmt.Errorf("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewPostLogic(r.Context(), svcCtx)
+		err := l.Restore(&req, userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "恢复文章成功",
+				"data":    nil,
+			})
+		}
+	}
+}
@@ -1,16 +1,50 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/zeromicro/go-zero/rest"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/task4/api/internal/logic"
 	"task4-go-zero/task4/api/internal/middleware"
 	"task4-go-zero/task4/api/internal/svc"
 )
 
+// routePolicies 需要登录的路由及其所需权限，没有列出的路由会被 RBACMiddleware 拒绝
+var routePolicies = map[string]auth.Permission{
+	"POST /api/v1/auth/logout":           auth.PermUserSelf,
+	"POST /api/v1/auth/logout-all":       auth.PermUserSelf,
+	"POST /api/v1/user/wallet/link":      auth.PermUserSelf,
+	"POST /api/v1/user/wallet/unlink":    auth.PermUserSelf,
+	"GET /api/v1/user/me":                auth.PermUserSelf,
+	"GET /api/v1/user/page":              auth.PermUserList,
+	"POST /api/v1/admin/user/role":       auth.PermUserRole,
+	"POST /api/v1/admin/category/create": auth.PermCategoryManage,
+	"POST /api/v1/post/create":           auth.PermPostCreate,
+	"GET /api/v1/post/page":              auth.PermPostRead,
+	"GET /api/v1/post/byId":              auth.PermPostRead,
+	"POST /api/v1/post/edit":             auth.PermPostEdit,
+	"POST /api/v1/post/delete":           auth.PermPostDelete,
+	"GET /api/v1/post/trash":             auth.PermTrash,
+	"POST /api/v1/post/restore":          auth.PermTrash,
+	"POST /api/v1/comment/create":        auth.PermCommentCreate,
+	"GET /api/v1/comment/byPostId":       auth.PermCommentRead,
+	"POST /api/v1/comment/edit":          auth.PermCommentEdit,
+	"POST /api/v1/comment/delete":        auth.PermCommentDelete,
+	"GET /api/v1/comment/trash":          auth.PermTrash,
+	"POST /api/v1/comment/restore":       auth.PermTrash,
+}
+
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
-	// 创建认证中间件
-	authMiddleware := middleware.NewAuthMiddleware(serverCtx.Config.Auth.JwtSecret)
+	// 创建认证中间件，每次请求从数据库读取当前角色
+	authMiddleware := middleware.NewAuthMiddleware(serverCtx.Config.Auth.JwtSecret, func(ctx context.Context, userID uint) (string, error) {
+		return logic.NewUserLogic(ctx, serverCtx).Role(userID)
+	}, func(ctx context.Context, jti string) (bool, error) {
+		return logic.NewTokenLogic(ctx, serverCtx).IsRevoked(jti)
+	})
+	rbacMiddleware := middleware.NewRBACMiddleware(routePolicies)
+	authorized := []rest.Middleware{authMiddleware.Handle, rbacMiddleware.Handle}
 
 	// 认证相关路由
 	server.AddRoutes(
@@ -27,6 +61,94 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/v1/auth/login",
 					Handler: UserLoginHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/auth/refresh",
+					Handler: TokenRefreshHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/auth/siwe/nonce",
+					Handler: SiweNonceHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/auth/siwe/login",
+					Handler: SiweLoginHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	// 退出登录，需要当前的 access token
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			authorized,
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/auth/logout",
+					Handler: LogoutHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/auth/logout-all",
+					Handler: LogoutAllHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	// 公开路由，不需要登录
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/posts",
+					Handler: PostFeedHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/posts/:id",
+					Handler: PostPublicDetailHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/users/:id/posts",
+					Handler: AuthorPostsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/search",
+					Handler: SearchHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/tags",
+					Handler: TagPageHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/tags/suggest",
+					Handler: TagSuggestHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/tags/:name/posts",
+					Handler: TagPostsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/categories",
+					Handler: CategoryPageHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/categories/:id/posts",
+					Handler: CategoryPostsHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -34,21 +156,55 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	// 用户相关路由
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{authMiddleware.Handle},
+			authorized,
 			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/user/me",
+					Handler: UserMeHandler(serverCtx),
+				},
 				{
 					Method:  http.MethodGet,
 					Path:    "/api/v1/user/page",
 					Handler: UserPageHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/user/wallet/link",
+					Handler: WalletLinkHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/user/wallet/unlink",
+					Handler: WalletUnlinkHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	// 管理员路由
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			authorized,
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/admin/user/role",
+					Handler: UserRoleHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/admin/category/create",
+					Handler: CategoryCreateHandler(serverCtx),
+				},
 			}...,
 		),
 	)
 
-	// 文章相关路由
+	// 文章相关路由，page 和 byId 只查询自己的文章，用于编辑
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{authMiddleware.Handle},
+			authorized,
 			[]rest.Route{
 				{
 					Method:  http.MethodPost,
@@ -71,10 +227,20 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Handler: PostEditHandler(serverCtx),
 				},
 				{
-					Method:  http.MethodGet,
+					Method:  http.MethodPost,
 					Path:    "/api/v1/post/delete",
 					Handler: PostDeleteHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/post/trash",
+					Handler: PostTrashHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/post/restore",
+					Handler: PostRestoreHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -82,7 +248,7 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	// 评论相关路由
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{authMiddleware.Handle},
+			authorized,
 			[]rest.Route{
 				{
 					Method:  http.MethodPost,
@@ -94,6 +260,26 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/api/v1/comment/byPostId",
 					Handler: CommentListHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/comment/edit",
+					Handler: CommentEditHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/comment/delete",
+					Handler: CommentDeleteHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/v1/comment/trash",
+					Handler: CommentTrashHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/v1/comment/restore",
+					Handler: CommentRestoreHandler(serverCtx),
+				},
 			}...,
 		),
 	)
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+	"github.com/zeromicro/go-zero/rest"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/search"
+	"task4-go-zero/task4/api/internal/config"
+	"task4-go-zero/task4/api/internal/svc"
+)
+
+const testJwtSecret = "test-secret"
+
+// publicRoutes 不需要登录的路由
+var publicRoutes = map[string]bool{
+	"POST /api/v1/auth/register":       true,
+	"POST /api/v1/auth/login":          true,
+	"POST /api/v1/auth/refresh":        true,
+	"POST /api/v1/auth/siwe/nonce":     true,
+	"POST /api/v1/auth/siwe/login":     true,
+	"GET /api/v1/posts":                true,
+	"GET /api/v1/posts/:id":            true,
+	"GET /api/v1/users/:id/posts":      true,
+	"GET /api/v1/search":               true,
+	"GET /api/v1/tags":                 true,
+	"GET /api/v1/tags/suggest":         true,
+	"GET /api/v1/tags/:name/posts":     true,
+	"GET /api/v1/categories":           true,
+	"GET /api/v1/categories/:id/posts": true,
+}
+
+// minRoles 需要登录的路由允许访问的最低角色，和 routePolicies 分开维护，修改权限时两处都要改
+var minRoles = map[string]string{
+	"POST /api/v1/auth/logout":           auth.RoleUser,
+	"POST /api/v1/auth/logout-all":       auth.RoleUser,
+	"POST /api/v1/user/wallet/link":      auth.RoleUser,
+	"POST /api/v1/user/wallet/unlink":    auth.RoleUser,
+	"GET /api/v1/user/me":                auth.RoleUser,
+	"GET /api/v1/user/page":              auth.RoleModerator,
+	"POST /api/v1/admin/user/role":       auth.RoleAdmin,
+	"POST /api/v1/admin/category/create": auth.RoleAdmin,
+	"POST /api/v1/post/create":           auth.RoleUser,
+	"GET /api/v1/post/page":              auth.RoleUser,
+	"GET /api/v1/post/byId":              auth.RoleUser,
+	"POST /api/v1/post/edit":             auth.RoleUser,
+	"POST /api/v1/post/delete":           auth.RoleUser,
+	"GET /api/v1/post/trash":             auth.RoleUser,
+	"POST /api/v1/post/restore":          auth.RoleUser,
+	"POST /api/v1/comment/create":        auth.RoleUser,
+	"GET /api/v1/comment/byPostId":       auth.RoleUser,
+	"POST /api/v1/comment/edit":          auth.RoleUser,
+	"POST /api/v1/comment/delete":        auth.RoleUser,
+	"GET /api/v1/comment/trash":          auth.RoleUser,
+	"POST /api/v1/comment/restore":       auth.RoleUser,
+}
+
+// roleLevels 角色从低到高，空字符串表示未登录
+var roleLevels = []string{"", auth.RoleUser, auth.RoleModerator, auth.RoleAdmin}
+
+func level(role string) int {
+	for i, r := range roleLevels {
+		if r == role {
+			return i
+		}
+	}
+	return -1
+}
+
+// registeredRoutes 按 RegisterHandlers 注册的顺序返回全部路由
+func registeredRoutes(t *testing.T, svcCtx *svc.ServiceContext) []rest.Route {
+	t.Helper()
+	server := rest.MustNewServer(rest.RestConf{
+		ServiceConf: service.ServiceConf{Name: "task4-api-test", Log: logx.LogConf{Mode: "console"}},
+		Host:        "127.0.0.1",
+		Port:        8888,
+	})
+	t.Cleanup(server.Stop)
+	RegisterHandlers(server, svcCtx)
+	return server.Routes()
+}
+
+// mockDB 替换 svcCtx.DB，role 不为空时期望认证中间件检查吊销并读取当前角色
+// 其他查询没有预期，返回错误，handler 按数据库错误处理
+func mockDB(t *testing.T, svcCtx *svc.ServiceContext, role string) sqlmock.Sqlmock {
+	t.Helper()
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role != "" {
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `revoked_tokens`").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("SELECT `role` FROM `users`").WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow(role))
+	}
+	svcCtx.DB = db
+	return mock
+}
+
+func TestRoutePolicies(t *testing.T) {
+	logx.Disable()
+	svcCtx := &svc.ServiceContext{
+		Config: config.Config{Auth: config.AuthConfig{JwtSecret: testJwtSecret, TokenExpiry: 900}},
+		Search: search.NewMemoryIndex(),
+	}
+	routes := registeredRoutes(t, svcCtx)
+
+	// 每个需要登录的路由都有策略，每个策略都对应一个已注册的路由
+	registered := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		registered[key] = true
+		_, hasPolicy := routePolicies[key]
+		if publicRoutes[key] == hasPolicy {
+			t.Errorf("%s: public = %v, policy = %v", key, publicRoutes[key], hasPolicy)
+		}
+		if _, ok := minRoles[key]; !publicRoutes[key] && !ok {
+			t.Errorf("%s 没有在 minRoles 中列出", key)
+		}
+	}
+	for key := range routePolicies {
+		if !registered[key] {
+			t.Errorf("策略 %s 没有对应的路由", key)
+		}
+	}
+	for key := range publicRoutes {
+		if !registered[key] {
+			t.Errorf("公开路由 %s 没有注册", key)
+		}
+	}
+
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		for _, role := range roleLevels {
+			name := key + " as " + role
+			if role == "" {
+				name = key + " as anonymous"
+			}
+			t.Run(name, func(t *testing.T) {
+				protected := !publicRoutes[key]
+				// 公开路由不经过认证中间件，不会读取角色
+				loaded := role
+				if !protected {
+					loaded = ""
+				}
+				mock := mockDB(t, svcCtx, loaded)
+
+				req := httptest.NewRequest(route.Method, route.Path, strings.NewReader("{}"))
+				req.Header.Set("Content-Type", "application/json")
+				if role != "" {
+					token, err := auth.GenerateToken(1, role, testJwtSecret, 900)
+					if err != nil {
+						t.Fatal(err)
+					}
+					req.Header.Set("Authorization", "Bearer "+token)
+				}
+				w := httptest.NewRecorder()
+				route.Handler(w, req)
+				body := w.Body.String()
+
+				switch {
+				case protected && role == "":
+					if w.Code != http.StatusBadRequest || !strings.Contains(body, errorx.ErrInvalidCredentials.Message) {
+						t.Fatalf("未登录访问应返回认证失败: %d %s", w.Code, body)
+					}
+				case protected && level(role) < level(minRoles[key]):
+					if w.Code != http.StatusBadRequest || !strings.Contains(body, errorx.ErrUnauthorized.Message) {
+						t.Fatalf("%s 访问应返回权限不足: %d %s", role, w.Code, body)
+					}
+				default:
+					// 通过认证和权限检查后由 handler 处理，数据库没有数据，只要求不是认证或权限错误
+					if strings.Contains(body, errorx.ErrInvalidCredentials.Message) || strings.Contains(body, errorx.ErrUnauthorized.Message) {
+						t.Fatalf("%s 应可以访问: %d %s", role, w.Code, body)
+					}
+				}
+				if protected && role != "" {
+					if err := mock.ExpectationsWereMet(); err != nil {
+						t.Fatalf("认证中间件没有读取角色: %v", err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/task4/api/internal/logic"
+	"task4-go-zero/task4/api/internal/svc"
+)
+
+func SearchHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SearchReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewSearchLogic(r.Context(), svcCtx)
+		resp, err := l.Search(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "搜索成功",
+				"data":    resp,
+			})
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/task4/api/internal/logic"
+	"task4-go-zero/task4/api/internal/middleware"
+	"task4-go-zero/task4/api/internal/svc"
+)
+
+func SiweNonceHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := logic.NewSiweLogic(r.Context(), svcCtx)
+		resp, err := l.Nonce()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "获取nonce成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func SiweLoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SiweReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewSiweLogic(r.Context(), svcCtx)
+		resp, err := l.Login(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "登录成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func WalletLinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SiweReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewSiweLogic(r.Context(), svcCtx)
+		resp, err := l.Link(&req, userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "绑定钱包成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func WalletUnlinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewSiweLogic(r.Context(), svcCtx)
+		err := l.Unlink(userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "解绑钱包成功",
+				"data":    nil,
+			})
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/task4/api/internal/logic"
+	"task4-go-zero/task4/api/internal/middleware"
+	"task4-go-zero/task4/api/internal/svc"
+)
+
+func TokenRefreshHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TokenRefreshReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewTokenLogic(r.Context(), svcCtx)
+		resp, err := l.Refresh(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "刷新成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func LogoutHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims := middleware.TokenClaims(r.Context())
+		if claims == nil {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取当前token"))
+			return
+		}
+
+		l := logic.NewTokenLogic(r.Context(), svcCtx)
+		err := l.Logout(claims)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "退出登录成功",
+				"data":    nil,
+			})
+		}
+	}
+}
+
+func LogoutAllHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewTokenLogic(r.Context(), svcCtx)
+		err := l.LogoutAll(userID)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "已退出所有会话",
+				"data":    nil,
+			})
+		}
+	}
+}
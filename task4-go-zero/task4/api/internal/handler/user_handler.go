@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"github.com/zeromicro/go-zero/rest/httpx"
 	"net/http"
 	"task4-go-zero/internal/types"
 	"task4-go-zero/task4/api/internal/logic"
+	"task4-go-zero/task4/api/internal/middleware"
 	"task4-go-zero/task4/api/internal/svc"
 )
 
@@ -61,8 +63,58 @@ func UserPageHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
+		l := logic.NewUserLogic(r.Context(), svcCtx)
+		resp, err := l.Page(&req, userID, middleware.UserRole(r.Context()))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "查询成功",
+				"data":    resp,
+			})
+		}
+	}
+}
+
+func UserRoleHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.UserRoleReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := logic.NewUserLogic(r.Context(), svcCtx)
+		err := l.SetRole(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, map[string]interface{}{
+				"code":    200,
+				"message": "修改角色成功",
+				"data":    nil,
+			})
+		}
+	}
+}
+
+func UserMeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(uint)
+		if !ok {
+			httpx.ErrorCtx(r.Context(), w, errors.New("无法获取用户ID"))
+			return
+		}
+
 		l := logic.NewUserLogic(r.Context(), svcCtx)
-		resp, err := l.Page(&req)
+		resp, err := l.Me(userID)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/pagination"
+	"task4-go-zero/task4/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxCategoryLength 分类名的最大字符数
+const maxCategoryLength = 32
+
+// categoryPaginator 按名称排序，附带未删除的文章数
+var categoryPaginator = pagination.New("categories").
+	Selecting("categories.*, (SELECT COUNT(*) FROM posts WHERE posts.category_id = categories.id AND posts.deleted_at IS NULL) AS post_count").
+	OrderBy("categories.name ASC, categories.id ASC")
+
+type CategoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCategoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CategoryLogic {
+	return &CategoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Create 创建分类，名称不能重复
+func (l *CategoryLogic) Create(req *types.CategoryCreateReq) (resp *types.CategoryResp, err error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxCategoryLength {
+		return nil, errorx.ErrInvalidParams
+	}
+
+	var existing types.Category
+	if err := l.svcCtx.DB.Where("name = ?", name).First(&existing).Error; err == nil {
+		return nil, errorx.ErrInvalidParams
+	}
+
+	category := types.Category{Name: name}
+	if err := l.svcCtx.DB.Create(&category).Error; err != nil {
+		return nil, errorx.ErrSystem
+	}
+
+	return types.NewCategoryResp(&category), nil
+}
+
+// Page 所有分类及其文章数
+func (l *CategoryLogic) Page(req *types.CategoryPageReq) (resp *pagination.Page[*types.CategoryResp], err error) {
+	page, err := pagination.Find[types.Category](l.svcCtx.DB.Model(&types.Category{}), req.Params, categoryPaginator)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return pagination.Map(page, types.NewCategoryResp), nil
+}
+
+// Posts 某个分类下的文章，不需要登录
+func (l *CategoryLogic) Posts(req *types.CategoryPostsReq) (resp *pagination.Page[*types.PostPublicResp], err error) {
+	var category types.Category
+	if err := l.svcCtx.DB.Where("id = ?", req.ID).First(&category).Error; err != nil {
+		return nil, errorx.ErrCategoryNotFound
+	}
+
+	query := l.svcCtx.DB.Model(&types.Post{}).Where("posts.category_id = ?", category.ID)
+	return NewPostLogic(l.ctx, l.svcCtx).publicPage(query, req.Params, req.Sort)
+}
@@ -3,13 +3,20 @@ package logic
 import (
 	"context"
 	errorx "task4-go-zero/internal/error"
+	"time"
 
 	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/pagination"
+	"task4-go-zero/pkg/search"
 	"task4-go-zero/task4/api/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// defaultCommentDepth 未配置 Comment.MaxDepth 时回复的最大层数
+const defaultCommentDepth = 3
+
 type CommentLogic struct {
 	logx.Logger
 	ctx    context.Context
@@ -38,18 +45,141 @@ func (l *CommentLogic) Create(req *types.CommentCreateReq, userID uint) error {
 		PostID:  req.PostID,
 	}
 
+	// 回复只能挂在同一篇文章中未删除的评论下，层数不能超过配置
+	if req.ParentID != 0 {
+		var parent types.Comment
+		if err := l.svcCtx.DB.Where("id = ? AND post_id = ?", req.ParentID, req.PostID).First(&parent).Error; err != nil {
+			return errorx.ErrCommentNotFound
+		}
+		if parent.Depth+1 > l.maxDepth() {
+			return errorx.ErrInvalidParams
+		}
+
+		comment.ParentID = &parent.ID
+		comment.RootID = parent.RootID
+		if parent.RootID == 0 {
+			comment.RootID = parent.ID
+		}
+		comment.Depth = parent.Depth + 1
+	}
+
 	if err := l.svcCtx.DB.Create(&comment).Error; err != nil {
 		return errorx.ErrSystem
 	}
+	indexDocuments(l.ctx, l.svcCtx, comment.SearchDocument())
+
+	return nil
+}
+
+// Edit 只能修改自己未删除的评论
+func (l *CommentLogic) Edit(req *types.CommentEditReq, userID uint) error {
+	var comment types.Comment
+	if err := l.svcCtx.DB.Where("id = ?", req.ID).First(&comment).Error; err != nil {
+		return errorx.ErrCommentNotFound
+	}
+	if comment.UserID != userID {
+		return errorx.ErrUnauthorized
+	}
+
+	if err := l.svcCtx.DB.Model(&comment).Update("content", req.Content).Error; err != nil {
+		return errorx.ErrSystem
+	}
+	comment.Content = req.Content
+	indexDocuments(l.ctx, l.svcCtx, comment.SearchDocument())
+
+	return nil
+}
+
+// Delete 作者可以删除自己的评论，版主和管理员可以删除任何评论
+// 评论进入作者的回收站，有未删除回复时在回复树中显示为占位
+func (l *CommentLogic) Delete(req *types.CommentDeleteReq, userID uint, role string) error {
+	var comment types.Comment
+	if err := l.svcCtx.DB.Where("id = ?", req.ID).First(&comment).Error; err != nil {
+		return errorx.ErrCommentNotFound
+	}
+	if comment.UserID != userID && !auth.Can(role, auth.PermCommentDeleteAny) {
+		return errorx.ErrUnauthorized
+	}
+
+	// 记录删除者，被版主删除的评论作者不能自行恢复
+	deleted := map[string]interface{}{"deleted_at": time.Now(), "deleted_by": userID}
+	if err := l.svcCtx.DB.Model(&comment).UpdateColumns(deleted).Error; err != nil {
+		return errorx.ErrSystem
+	}
+	unindexDocuments(l.ctx, l.svcCtx, search.KindComment, comment.ID)
+
+	return nil
+}
+
+// Trash 自己被删除的评论，被版主删除的带有 deleted_by_moderator 标记
+// 随文章一起删除的评论跟随文章恢复，不在这里列出
+func (l *CommentLogic) Trash(req *types.TrashPageReq, userID uint) (resp *pagination.Page[*types.CommentResp], err error) {
+	query := l.svcCtx.DB.Unscoped().Model(&types.Comment{}).
+		Joins("JOIN posts ON posts.id = comments.post_id AND posts.deleted_at IS NULL").
+		Where("comments.user_id = ? AND comments.deleted_at IS NOT NULL", userID)
+	paginator := commentPaginator.OrderBy("comments.deleted_at DESC, comments.id DESC").Selecting("comments.*")
+	page, err := pagination.Find[types.Comment](query, req.Params, paginator)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return pagination.Map(page, types.NewTrashCommentResp), nil
+}
+
+// Restore 恢复自己删除的评论，所在文章已删除时需要先恢复文章，被版主删除的评论不能自行恢复
+func (l *CommentLogic) Restore(req *types.TrashRestoreReq, userID uint) error {
+	var comment types.Comment
+	if err := l.svcCtx.DB.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", req.ID).First(&comment).Error; err != nil {
+		return errorx.ErrCommentNotFound
+	}
+	if comment.UserID != userID || comment.DeletedByModerator() {
+		return errorx.ErrUnauthorized
+	}
+
+	var post types.Post
+	if err := l.svcCtx.DB.Where("id = ?", comment.PostID).First(&post).Error; err != nil {
+		return errorx.ErrPostNotFound
+	}
+
+	if err := l.svcCtx.DB.Unscoped().Model(&comment).UpdateColumns(map[string]interface{}{"deleted_at": nil, "deleted_by": 0}).Error; err != nil {
+		return errorx.ErrSystem
+	}
+	indexDocuments(l.ctx, l.svcCtx, comment.SearchDocument())
 
 	return nil
 }
 
-func (l *CommentLogic) List(req *types.CommentListReq) (resp []types.Comment, err error) {
-	var comments []types.Comment
-	if err := l.svcCtx.DB.Where("post_id = ?", req.PostID).Find(&comments).Error; err != nil {
+// List 按顶层评论分页，每条顶层评论带完整的回复树
+func (l *CommentLogic) List(req *types.CommentListReq) (resp *pagination.Page[*types.CommentResp], err error) {
+	// 已删除的顶层评论只要还有未删除的回复就保留为占位
+	query := l.svcCtx.DB.Unscoped().Model(&types.Comment{}).
+		Where("comments.post_id = ? AND comments.parent_id IS NULL", req.PostID).
+		Where("comments.deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments AS r WHERE r.root_id = comments.id AND r.deleted_at IS NULL)")
+	page, err := pagination.Find[types.Comment](query, req.Params, commentPaginator)
+	if err != nil {
+		return nil, pageError(err)
+	}
+	resp = pagination.Map(page, types.NewCommentResp)
+	if len(page.Data) == 0 {
+		return resp, nil
+	}
+
+	rootIDs := make([]uint, 0, len(page.Data))
+	for _, root := range page.Data {
+		rootIDs = append(rootIDs, root.ID)
+	}
+	var replies []types.Comment
+	if err := l.svcCtx.DB.Unscoped().Where("root_id IN ?", rootIDs).Order("created_at ASC, id ASC").Find(&replies).Error; err != nil {
 		return nil, errorx.ErrSystem
 	}
+	resp.Data = types.NewCommentTree(resp.Data, replies)
 
-	return comments, nil
+	return resp, nil
+}
+
+func (l *CommentLogic) maxDepth() int {
+	if depth := l.svcCtx.Config.Comment.MaxDepth; depth > 0 {
+		return depth
+	}
+	return defaultCommentDepth
 }
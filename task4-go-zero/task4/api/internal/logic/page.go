@@ -0,0 +1,23 @@
+package logic
+
+import (
+	"errors"
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/pkg/pagination"
+)
+
+// 各列表接口的分页方式
+var (
+	userPaginator = pagination.New("users")
+	postPaginator = pagination.New("posts")
+	// 评论从旧到新展示
+	commentPaginator = pagination.New("comments").Ascending()
+)
+
+// pageError 转换分页查询的错误，游标无效属于参数错误
+func pageError(err error) error {
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return errorx.ErrInvalidParams
+	}
+	return errorx.ErrSystem
+}
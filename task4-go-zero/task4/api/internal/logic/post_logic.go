@@ -4,7 +4,13 @@ import (
 	"context"
 	errorx "task4-go-zero/internal/error"
 	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/pagination"
+	"task4-go-zero/pkg/search"
 	"task4-go-zero/task4/api/internal/svc"
+	"time"
+
+	"gorm.io/gorm"
 )
 
 type PostLogic struct {
@@ -20,92 +26,271 @@ func NewPostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PostLogic {
 }
 
 func (l *PostLogic) Create(req *types.PostCreateReq, userID uint) error {
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return err
+	}
+
 	post := types.Post{
 		Title:   req.Title,
 		Content: req.Content,
 		UserID:  userID,
 	}
+	if req.CategoryID != 0 {
+		if err := l.checkCategory(req.CategoryID); err != nil {
+			return err
+		}
+		post.CategoryID = &req.CategoryID
+	}
 
-	if err := l.svcCtx.DB.Create(&post).Error; err != nil {
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags", "Category").Create(&post).Error; err != nil {
+			return err
+		}
+		return setPostTags(tx, &post, tags)
+	})
+	if err != nil {
 		return errorx.ErrSystem
 	}
+	indexDocuments(l.ctx, l.svcCtx, post.SearchDocument())
 
 	return nil
 }
 
-func (l *PostLogic) Page(req *types.PostPageReq, userID uint) (resp interface{}, err error) {
-	var posts []types.Post
-	var total int64
+// Page 自己的文章列表
+func (l *PostLogic) Page(req *types.PostPageReq, userID uint) (resp *pagination.Page[*types.PostResp], err error) {
+	query := withPostRelations(l.svcCtx.DB.Model(&types.Post{})).Where("user_id = ?", userID)
+	page, err := pagination.Find[types.Post](query, req.Params, postPaginator)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return pagination.Map(page, types.NewPostResp), nil
+}
 
-	// 查询总数
-	if err := l.svcCtx.DB.Model(&types.Post{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
-		return nil, errorx.ErrSystem
+func (l *PostLogic) Detail(req *types.PostDetailReq, userID uint) (resp *types.PostResp, err error) {
+	var post types.Post
+	if err := withPostRelations(l.svcCtx.DB).Where("id = ? AND user_id = ?", req.PostID, userID).First(&post).Error; err != nil {
+		return nil, errorx.ErrPostNotFound
 	}
 
-	// 分页查询
-	page := req.Page
-	pageSize := req.PageSize
-	if page <= 0 {
-		page = 1
+	return types.NewPostResp(&post), nil
+}
+
+func (l *PostLogic) Edit(req *types.PostEditReq, userID uint) error {
+	var post types.Post
+	if err := l.svcCtx.DB.Where("id = ? AND user_id = ?", req.ID, userID).First(&post).Error; err != nil {
+		return errorx.ErrUnauthorized
+	}
+
+	// tags 为 nil 表示不修改标签
+	var tags []string
+	if req.Tags != nil {
+		var err error
+		if tags, err = normalizeTags(req.Tags); err != nil {
+			return err
+		}
 	}
-	if pageSize <= 0 {
-		pageSize = 10
+	if req.CategoryID != nil && *req.CategoryID != 0 {
+		if err := l.checkCategory(*req.CategoryID); err != nil {
+			return err
+		}
 	}
 
-	offset := (page - 1) * pageSize
-	if err := l.svcCtx.DB.Where("user_id = ?", userID).Offset(offset).Limit(pageSize).Find(&posts).Error; err != nil {
-		return nil, errorx.ErrSystem
+	// 更新文章
+	err := l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&post).Updates(types.Post{
+			Title:   req.Title,
+			Content: req.Content,
+		}).Error; err != nil {
+			return err
+		}
+		if req.CategoryID != nil {
+			var categoryID interface{}
+			if *req.CategoryID != 0 {
+				categoryID = *req.CategoryID
+			}
+			if err := tx.Model(&post).Update("category_id", categoryID).Error; err != nil {
+				return err
+			}
+		}
+		if req.Tags != nil {
+			return setPostTags(tx, &post, tags)
+		}
+		return nil
+	})
+	if err != nil {
+		return errorx.ErrSystem
 	}
 
-	// 计算总页数
-	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+	// 重新读取后更新搜索索引，Updates 会跳过空字段
+	if err := withPostRelations(l.svcCtx.DB).Where("id = ?", post.ID).First(&post).Error; err == nil {
+		indexDocuments(l.ctx, l.svcCtx, post.SearchDocument())
+	}
 
-	return map[string]interface{}{
-		"total":       total,
-		"page":        page,
-		"pageSize":    pageSize,
-		"totalPages":  totalPages,
-		"hasNextPage": page < totalPages,
-		"data":        posts,
-	}, nil
+	return nil
 }
 
-func (l *PostLogic) Detail(req *types.PostDetailReq, userID uint) (resp *types.Post, err error) {
+func (l *PostLogic) Delete(req *types.PostDeleteReq, userID uint, role string) error {
 	var post types.Post
-	if err := l.svcCtx.DB.Where("id = ? AND user_id = ?", req.PostID, userID).First(&post).Error; err != nil {
-		return nil, errorx.ErrPostNotFound
+	if err := l.svcCtx.DB.Where("id = ?", req.ID).First(&post).Error; err != nil {
+		return errorx.ErrPostNotFound
+	}
+
+	// 作者可以删除自己的文章，版主和管理员可以删除任何文章
+	if post.UserID != userID && !auth.Can(role, auth.PermPostDeleteAny) {
+		return errorx.ErrUnauthorized
+	}
+
+	// 软删除文章和它的评论，评论使用和文章相同的删除时间，恢复文章时据此只恢复一起删除的评论
+	now := time.Now()
+	var commentIDs []uint
+	err := l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&types.Comment{}).Where("post_id = ?", post.ID).Pluck("id", &commentIDs).Error; err != nil {
+			return err
+		}
+		deleted := map[string]interface{}{"deleted_at": now, "deleted_by": userID}
+		if err := tx.Model(&post).UpdateColumns(deleted).Error; err != nil {
+			return err
+		}
+		return tx.Model(&types.Comment{}).Where("post_id = ?", post.ID).UpdateColumns(deleted).Error
+	})
+	if err != nil {
+		return errorx.ErrSystem
 	}
+	unindexDocuments(l.ctx, l.svcCtx, search.KindPost, post.ID)
+	unindexDocuments(l.ctx, l.svcCtx, search.KindComment, commentIDs...)
 
-	return &post, nil
+	return nil
 }
 
-func (l *PostLogic) Edit(req *types.PostEditReq, userID uint) error {
+// Trash 回收站中自己的文章，包括被版主删除的，这些文章带有 deleted_by_moderator 标记
+func (l *PostLogic) Trash(req *types.TrashPageReq, userID uint) (resp *pagination.Page[*types.PostResp], err error) {
+	query := withPostRelations(l.svcCtx.DB.Unscoped().Model(&types.Post{})).Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+	page, err := pagination.Find[types.Post](query, req.Params, postPaginator.OrderBy("posts.deleted_at DESC, posts.id DESC"))
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return pagination.Map(page, types.NewTrashPostResp), nil
+}
+
+// Restore 恢复自己删除的文章，以及随文章一起删除的评论，被版主删除的文章不能自行恢复
+func (l *PostLogic) Restore(req *types.TrashRestoreReq, userID uint) error {
 	var post types.Post
-	if err := l.svcCtx.DB.Where("id = ? AND user_id = ?", req.ID, userID).First(&post).Error; err != nil {
+	if err := l.svcCtx.DB.Unscoped().Preload("Tags").Where("id = ? AND deleted_at IS NOT NULL", req.ID).First(&post).Error; err != nil {
+		return errorx.ErrPostNotFound
+	}
+	if post.UserID != userID || post.DeletedByModerator() {
 		return errorx.ErrUnauthorized
 	}
 
-	// 更新文章
-	if err := l.svcCtx.DB.Model(&post).Updates(types.Post{
-		Title:   req.Title,
-		Content: req.Content,
-	}).Error; err != nil {
+	restored := map[string]interface{}{"deleted_at": nil, "deleted_by": 0}
+	err := l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&types.Comment{}).
+			Where("post_id = ? AND deleted_at = ?", post.ID, post.DeletedAt.Time).
+			UpdateColumns(restored).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&post).UpdateColumns(restored).Error
+	})
+	if err != nil {
 		return errorx.ErrSystem
 	}
+	indexDocuments(l.ctx, l.svcCtx, post.SearchDocument())
+	indexPostComments(l.ctx, l.svcCtx, post.ID)
 
 	return nil
 }
 
-func (l *PostLogic) Delete(req *types.PostDeleteReq, userID uint) error {
+// Feed 所有人的文章列表，不需要登录
+func (l *PostLogic) Feed(req *types.PostFeedReq) (resp *pagination.Page[*types.PostPublicResp], err error) {
+	return l.publicPage(l.svcCtx.DB.Model(&types.Post{}), req.Params, req.Sort)
+}
+
+// AuthorPosts 某个作者的文章列表，不需要登录
+func (l *PostLogic) AuthorPosts(req *types.AuthorPostsReq) (resp *pagination.Page[*types.PostPublicResp], err error) {
+	var author types.User
+	if err := l.svcCtx.DB.Where("id = ?", req.UserID).First(&author).Error; err != nil {
+		return nil, errorx.ErrUserNotFound
+	}
+
+	query := l.svcCtx.DB.Model(&types.Post{}).Where("posts.user_id = ?", req.UserID)
+	return l.publicPage(query, req.Params, req.Sort)
+}
+
+// PublicDetail 公开的文章详情，不限制作者
+func (l *PostLogic) PublicDetail(req *types.PostPublicReq) (resp *types.PostPublicResp, err error) {
 	var post types.Post
-	if err := l.svcCtx.DB.Where("id = ? AND user_id = ?", req.PostID, userID).First(&post).Error; err != nil {
-		return errorx.ErrUnauthorized
+	if err := withPostRelations(l.svcCtx.DB).Where("id = ?", req.ID).First(&post).Error; err != nil {
+		return nil, errorx.ErrPostNotFound
 	}
 
-	// 删除文章
-	if err := l.svcCtx.DB.Delete(&post).Error; err != nil {
-		return errorx.ErrSystem
+	if err := l.svcCtx.DB.Model(&types.Comment{}).Where("post_id = ?", post.ID).Count(&post.CommentCount).Error; err != nil {
+		return nil, errorx.ErrSystem
 	}
 
+	authors, err := l.authors([]uint{post.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewPostPublicResp(&post, authors[post.UserID]), nil
+}
+
+// publicPage 分页查询 query 中的文章，附带作者和评论数
+func (l *PostLogic) publicPage(query *gorm.DB, params pagination.Params, sort string) (*pagination.Page[*types.PostPublicResp], error) {
+	paginator := postPaginator.Selecting("posts.*, (SELECT COUNT(*) FROM comments WHERE comments.post_id = posts.id AND comments.deleted_at IS NULL) AS comment_count")
+	if sort == types.PostSortComments {
+		// 评论数相同时按发布时间排序，id 保证顺序稳定
+		paginator = paginator.OrderBy("comment_count DESC, posts.created_at DESC, posts.id DESC")
+	}
+
+	page, err := pagination.Find[types.Post](withPostRelations(query), params, paginator)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	userIDs := make([]uint, 0, len(page.Data))
+	for _, row := range page.Data {
+		userIDs = append(userIDs, row.UserID)
+	}
+	authors, err := l.authors(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.Map(page, func(post *types.Post) *types.PostPublicResp {
+		return types.NewPostPublicResp(post, authors[post.UserID])
+	}), nil
+}
+
+// authors 批量查询作者，按用户ID索引
+func (l *PostLogic) authors(userIDs []uint) (map[uint]*types.User, error) {
+	authors := make(map[uint]*types.User, len(userIDs))
+	if len(userIDs) == 0 {
+		return authors, nil
+	}
+
+	var users []types.User
+	if err := l.svcCtx.DB.Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		return nil, errorx.ErrSystem
+	}
+	for i := range users {
+		authors[users[i].ID] = &users[i]
+	}
+	return authors, nil
+}
+
+// withPostRelations 查询文章时一起加载标签和分类
+func withPostRelations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags").Preload("Category")
+}
+
+func (l *PostLogic) checkCategory(id uint) error {
+	var category types.Category
+	if err := l.svcCtx.DB.Where("id = ?", id).First(&category).Error; err != nil {
+		return errorx.ErrCategoryNotFound
+	}
 	return nil
 }
@@ -0,0 +1,124 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"task4-go-zero/internal/types"
+	"task4-go-zero/task4/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 未配置 Trash 时的保留时间和清理间隔
+const (
+	defaultTrashRetention = 30 * 24 * time.Hour
+	defaultPurgeInterval  = time.Hour
+)
+
+// purgeBatch 每次彻底删除的最大条数
+const purgeBatch = 500
+
+// PurgeLogic 彻底删除回收站中超过保留期的文章和评论，同时清理过期的 token
+type PurgeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPurgeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PurgeLogic {
+	return &PurgeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Run 按配置的间隔定期清理，直到 ctx 取消
+func (l *PurgeLogic) Run() {
+	interval := l.svcCtx.Config.Trash.PurgeInterval
+	if interval <= 0 {
+		interval = defaultPurgeInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		posts, comments, err := l.Purge(time.Now())
+		if err != nil {
+			l.Errorf("清理回收站失败: %v", err)
+		} else if posts > 0 || comments > 0 {
+			l.Infof("清理回收站: 文章 %d 篇, 评论 %d 条", posts, comments)
+		}
+		if n, err := NewTokenLogic(l.ctx, l.svcCtx).Cleanup(time.Now()); err != nil {
+			l.Errorf("清理过期 token 失败: %v", err)
+		} else if n > 0 {
+			l.Infof("清理过期 token: %d 条", n)
+		}
+
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge 彻底删除在 now 减去保留时间之前删除的文章和评论
+// 文章连同它的全部评论一起删除；评论只有在没有任何回复时才删除，避免回复树断开
+func (l *PurgeLogic) Purge(now time.Time) (posts, comments int64, err error) {
+	retention := l.svcCtx.Config.Trash.Retention
+	if retention <= 0 {
+		retention = defaultTrashRetention
+	}
+	cutoff := now.Add(-retention)
+	db := l.svcCtx.DB.WithContext(l.ctx).Unscoped()
+
+	for {
+		var ids []uint
+		if err := db.Model(&types.Post{}).Where("deleted_at < ?", cutoff).Limit(purgeBatch).Pluck("id", &ids).Error; err != nil {
+			return posts, comments, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		res := db.Where("post_id IN ?", ids).Delete(&types.Comment{})
+		if res.Error != nil {
+			return posts, comments, res.Error
+		}
+		comments += res.RowsAffected
+
+		if err := db.Exec("DELETE FROM post_tags WHERE post_id IN ?", ids).Error; err != nil {
+			return posts, comments, err
+		}
+
+		res = db.Where("id IN ?", ids).Delete(&types.Post{})
+		if res.Error != nil {
+			return posts, comments, res.Error
+		}
+		posts += res.RowsAffected
+	}
+
+	// 删除叶子评论后它的父评论可能也变成叶子，循环直到没有可删除的评论
+	for {
+		var ids []uint
+		if err := db.Model(&types.Comment{}).
+			Where("deleted_at < ?", cutoff).
+			Where("NOT EXISTS (SELECT 1 FROM comments AS r WHERE r.parent_id = comments.id)").
+			Limit(purgeBatch).Pluck("id", &ids).Error; err != nil {
+			return posts, comments, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		res := db.Where("id IN ?", ids).Delete(&types.Comment{})
+		if res.Error != nil {
+			return posts, comments, res.Error
+		}
+		comments += res.RowsAffected
+	}
+
+	return posts, comments, nil
+}
@@ -0,0 +1,127 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/pagination"
+	"task4-go-zero/pkg/search"
+	"task4-go-zero/task4/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SearchLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSearchLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchLogic {
+	return &SearchLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Search 搜索未删除的文章或评论，按相关度排序
+func (l *SearchLogic) Search(req *types.SearchReq) (resp *pagination.Page[*types.SearchHitResp], err error) {
+	if req.Cursor != "" {
+		return nil, errorx.ErrInvalidParams
+	}
+	from, err := parseSearchTime(req.From, false)
+	if err != nil {
+		return nil, errorx.ErrInvalidParams
+	}
+	to, err := parseSearchTime(req.To, true)
+	if err != nil {
+		return nil, errorx.ErrInvalidParams
+	}
+
+	// 只有文章有标签
+	tag := normalizeTag(req.Tag)
+	if tag != "" && req.Type == search.KindComment {
+		return nil, errorx.ErrInvalidParams
+	}
+
+	page, pageSize := req.Normalize()
+	result, err := l.svcCtx.Search.Search(l.ctx, search.Query{
+		Text:   req.Q,
+		Kind:   req.Type,
+		UserID: req.AuthorID,
+		Tag:    tag,
+		From:   from,
+		To:     to,
+		Offset: (page - 1) * pageSize,
+		Limit:  pageSize,
+	})
+	if err != nil {
+		if errors.Is(err, search.ErrEmptyQuery) {
+			return nil, errorx.ErrInvalidParams
+		}
+		l.Errorf("搜索失败: %v", err)
+		return nil, errorx.ErrSystem
+	}
+
+	hits := make([]*types.SearchHitResp, 0, len(result.Hits))
+	for i := range result.Hits {
+		hits = append(hits, types.NewSearchHitResp(&result.Hits[i]))
+	}
+	return pagination.NewOffsetPage(hits, result.Total, page, pageSize), nil
+}
+
+// parseSearchTime 解析日期或时间，end 为 true 时只有日期的结束时间取第二天零点
+func parseSearchTime(s string, end bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
+// indexDocuments 更新搜索索引，失败只记录日志，数据以数据库为准
+func indexDocuments(ctx context.Context, svcCtx *svc.ServiceContext, docs ...search.Document) {
+	if len(docs) == 0 {
+		return
+	}
+	if err := svcCtx.Search.Index(ctx, docs...); err != nil {
+		logx.WithContext(ctx).Errorf("更新搜索索引失败: %v", err)
+	}
+}
+
+// unindexDocuments 从搜索索引中删除
+func unindexDocuments(ctx context.Context, svcCtx *svc.ServiceContext, kind string, ids ...uint) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := svcCtx.Search.Delete(ctx, kind, ids...); err != nil {
+		logx.WithContext(ctx).Errorf("删除搜索索引失败: %v", err)
+	}
+}
+
+// indexPostComments 重新索引文章下所有未删除的评论，恢复文章后使用
+func indexPostComments(ctx context.Context, svcCtx *svc.ServiceContext, postID uint) {
+	var comments []types.Comment
+	if err := svcCtx.DB.Where("post_id = ?", postID).Find(&comments).Error; err != nil {
+		logx.WithContext(ctx).Errorf("更新搜索索引失败: %v", err)
+		return
+	}
+	docs := make([]search.Document, 0, len(comments))
+	for i := range comments {
+		docs = append(docs, comments[i].SearchDocument())
+	}
+	indexDocuments(ctx, svcCtx, docs...)
+}
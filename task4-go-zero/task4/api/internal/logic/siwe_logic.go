@@ -0,0 +1,183 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/siwe"
+	"task4-go-zero/task4/api/internal/svc"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 未配置 Siwe 时使用的默认值
+const (
+	defaultSiweDomain      = "localhost:8888"
+	defaultSiweChainID     = 1
+	defaultSiweNonceExpiry = 5 * time.Minute
+)
+
+// SiweLogic 使用以太坊钱包签名 (EIP-4361) 登录和绑定钱包
+type SiweLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSiweLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SiweLogic {
+	return &SiweLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Nonce 签发一个一次性的 nonce，以及前端组装消息需要的其他字段
+func (l *SiweLogic) Nonce() (resp *types.SiweNonceResp, err error) {
+	nonce, err := siwe.NewNonce()
+	if err != nil {
+		return nil, errorx.ErrSystem
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(l.nonceExpiry())
+	if err := l.svcCtx.DB.Create(&types.SiweNonce{Nonce: nonce, ExpiresAt: expiresAt}).Error; err != nil {
+		return nil, errorx.ErrSystem
+	}
+
+	domain, uri, chainID := l.expected()
+	return &types.SiweNonceResp{
+		Domain:         domain,
+		URI:            uri,
+		ChainID:        chainID,
+		Nonce:          nonce,
+		IssuedAt:       now.UTC().Truncate(time.Second),
+		ExpirationTime: expiresAt.UTC().Truncate(time.Second),
+	}, nil
+}
+
+// Login 已绑定的钱包签名登录，返回和密码登录相同的 token
+func (l *SiweLogic) Login(req *types.SiweReq) (resp *types.UserLoginResp, err error) {
+	address, err := l.verify(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var user types.User
+	if err := l.svcCtx.DB.Where("wallet_address = ?", address).First(&user).Error; err != nil {
+		return nil, errorx.ErrWalletNotLinked
+	}
+
+	return NewTokenLogic(l.ctx, l.svcCtx).Issue(&user)
+}
+
+// Link 当前用户绑定签名的钱包，一个钱包只能绑定一个用户，重新绑定会替换原来的钱包
+func (l *SiweLogic) Link(req *types.SiweReq, userID uint) (resp *types.UserResp, err error) {
+	address, err := l.verify(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var user types.User
+	if err := l.svcCtx.DB.Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, errorx.ErrUserNotFound
+	}
+
+	var owner types.User
+	err = l.svcCtx.DB.Where("wallet_address = ?", address).First(&owner).Error
+	if err == nil && owner.ID != userID {
+		return nil, errorx.ErrWalletLinked
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorx.ErrSystem
+	}
+
+	// 唯一索引保证并发绑定同一个钱包时只有一个成功，其他数据库错误不能当作已被绑定
+	if err := l.svcCtx.DB.Model(&user).Update("wallet_address", address).Error; err != nil {
+		if isDuplicateKey(err) {
+			return nil, errorx.ErrWalletLinked
+		}
+		return nil, errorx.ErrSystem
+	}
+	user.WalletAddress = &address
+
+	return types.NewUserResp(&user, types.VisibilityOwner), nil
+}
+
+// Unlink 解除当前用户绑定的钱包
+func (l *SiweLogic) Unlink(userID uint) error {
+	if err := l.svcCtx.DB.Model(&types.User{}).Where("id = ?", userID).Update("wallet_address", nil).Error; err != nil {
+		return errorx.ErrSystem
+	}
+	return nil
+}
+
+// verify 检查消息和签名，并消耗消息中的 nonce，返回签名的地址
+func (l *SiweLogic) verify(req *types.SiweReq) (string, error) {
+	msg, err := siwe.ParseMessage(req.Message)
+	if err != nil {
+		return "", errorx.ErrInvalidParams
+	}
+
+	domain, uri, chainID := l.expected()
+	if msg.URI != uri {
+		return "", errorx.ErrInvalidCredentials
+	}
+	address, err := msg.Verify(req.Message, req.Signature, siwe.VerifyOptions{
+		Domain:  domain,
+		ChainID: chainID,
+		MaxAge:  l.nonceExpiry(),
+	})
+	if err != nil {
+		l.Infof("SIWE 验证失败: %v", err)
+		return "", errorx.ErrInvalidCredentials
+	}
+
+	// 带条件更新，同一个 nonce 只能成功使用一次
+	now := time.Now()
+	res := l.svcCtx.DB.Model(&types.SiweNonce{}).
+		Where("nonce = ? AND used_at IS NULL AND expires_at > ?", msg.Nonce, now).
+		UpdateColumn("used_at", now)
+	if res.Error != nil {
+		return "", errorx.ErrSystem
+	}
+	if res.RowsAffected == 0 {
+		return "", errorx.ErrInvalidCredentials
+	}
+
+	return address.Hex(), nil
+}
+
+// expected 签名消息中 domain、URI、chain id 应有的值
+func (l *SiweLogic) expected() (domain, uri string, chainID int64) {
+	c := l.svcCtx.Config.Siwe
+	domain, uri, chainID = c.Domain, c.URI, c.ChainID
+	if domain == "" {
+		domain = defaultSiweDomain
+	}
+	if uri == "" {
+		uri = "http://" + domain
+	}
+	if chainID == 0 {
+		chainID = defaultSiweChainID
+	}
+	return domain, uri, chainID
+}
+
+// isDuplicateKey 是否违反唯一索引，MySQL 错误码 1062
+func isDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.Is(err, gorm.ErrDuplicatedKey) || errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+func (l *SiweLogic) nonceExpiry() time.Duration {
+	if l.svcCtx.Config.Siwe.NonceExpiry <= 0 {
+		return defaultSiweNonceExpiry
+	}
+	return l.svcCtx.Config.Siwe.NonceExpiry
+}
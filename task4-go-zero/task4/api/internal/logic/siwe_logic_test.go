@@ -0,0 +1,153 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-sql-driver/mysql"
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/siwe"
+)
+
+// siweReq 用新生成的私钥签名默认配置下的登录消息，modify 可以在签名前修改消息
+func siweReq(t *testing.T, modify func(m *siwe.Message)) (*types.SiweReq, string) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	m := &siwe.Message{
+		Domain:   defaultSiweDomain,
+		Address:  address,
+		URI:      "http://" + defaultSiweDomain,
+		Version:  siwe.Version,
+		ChainID:  defaultSiweChainID,
+		Nonce:    "abcdefgh12345678",
+		IssuedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	if modify != nil {
+		modify(m)
+	}
+	text := m.String()
+	sig, err := crypto.Sign(siwe.TextHash(text), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return &types.SiweReq{Message: text, Signature: hexutil.Encode(sig)}, address
+}
+
+// expectUseNonce 期望消耗 nonce，affected 为 0 表示 nonce 已使用或不存在
+func expectUseNonce(mock sqlmock.Sqlmock, affected int64) {
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `siwe_nonces` SET `used_at`=\\? WHERE nonce = \\? AND used_at IS NULL AND expires_at > \\?").
+		WithArgs(sqlmock.AnyArg(), "abcdefgh12345678", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, affected))
+	mock.ExpectCommit()
+}
+
+func TestSiweVerifyNonceOnce(t *testing.T) {
+	svcCtx, mock := newMockSvc(t)
+	req, address := siweReq(t, nil)
+	expectUseNonce(mock, 1)
+	expectUseNonce(mock, 0)
+
+	l := NewSiweLogic(context.Background(), svcCtx)
+	got, err := l.verify(req)
+	if err != nil || got != address {
+		t.Fatalf("verify = %s, %v, want %s", got, err, address)
+	}
+	// 同一条签名再次提交，nonce 已经用过
+	if _, err := l.verify(req); !errors.Is(err, errorx.ErrInvalidCredentials) {
+		t.Fatalf("重放 error = %v, want ErrInvalidCredentials", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSiweVerifyRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *siwe.Message)
+		want   error
+	}{
+		{"URI 不一致", func(m *siwe.Message) { m.URI = "http://evil.com" }, errorx.ErrInvalidCredentials},
+		{"domain 不一致", func(m *siwe.Message) { m.Domain = "evil.com" }, errorx.ErrInvalidCredentials},
+		{"chain id 不一致", func(m *siwe.Message) { m.ChainID = 5 }, errorx.ErrInvalidCredentials},
+		{"已过期", func(m *siwe.Message) { m.ExpirationTime = time.Now().Add(-time.Second) }, errorx.ErrInvalidCredentials},
+		{"还未生效", func(m *siwe.Message) { m.NotBefore = time.Now().Add(time.Minute) }, errorx.ErrInvalidCredentials},
+		{"签发时间超过 nonce 有效期", func(m *siwe.Message) { m.IssuedAt = time.Now().Add(-time.Hour) }, errorx.ErrInvalidCredentials},
+		{"地址不是校验和格式", func(m *siwe.Message) { m.Address = "0x" + m.Address[2:12] + "zz" }, errorx.ErrInvalidParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, mock := newMockSvc(t)
+			req, _ := siweReq(t, tt.modify)
+			if _, err := NewSiweLogic(context.Background(), svcCtx).verify(req); !errors.Is(err, tt.want) {
+				t.Fatalf("verify error = %v, want %v", err, tt.want)
+			}
+			// 消息无效时不消耗 nonce
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestSiweLinkErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"唯一索引冲突", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, errorx.ErrWalletLinked},
+		{"其他数据库错误", &mysql.MySQLError{Number: 1205, Message: "Lock wait timeout exceeded"}, errorx.ErrSystem},
+		{"连接错误", errors.New("connection refused"), errorx.ErrSystem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, mock := newMockSvc(t)
+			req, _ := siweReq(t, nil)
+			expectUseNonce(mock, 1)
+			mock.ExpectQuery("SELECT \\* FROM `users` WHERE id = \\?").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow(1, "alice"))
+			mock.ExpectQuery("SELECT \\* FROM `users` WHERE wallet_address = \\?").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE `users` SET `wallet_address`=\\?").WillReturnError(tt.err)
+			mock.ExpectRollback()
+
+			if _, err := NewSiweLogic(context.Background(), svcCtx).Link(req, 1); !errors.Is(err, tt.want) {
+				t.Fatalf("Link error = %v, want %v", err, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestSiweLinkedByOther(t *testing.T) {
+	svcCtx, mock := newMockSvc(t)
+	req, _ := siweReq(t, nil)
+	expectUseNonce(mock, 1)
+	mock.ExpectQuery("SELECT \\* FROM `users` WHERE id = \\?").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery("SELECT \\* FROM `users` WHERE wallet_address = \\?").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	if _, err := NewSiweLogic(context.Background(), svcCtx).Link(req, 1); !errors.Is(err, errorx.ErrWalletLinked) {
+		t.Fatalf("Link error = %v, want ErrWalletLinked", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,158 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/pagination"
+	"task4-go-zero/task4/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// 每篇文章最多的标签数
+	maxPostTags = 10
+	// 标签名的最大字符数
+	maxTagLength = 32
+	// 标签补全默认和最多返回的条数
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 20
+)
+
+// tagPostCount 标签下未删除的文章数
+const tagPostCount = "(SELECT COUNT(*) FROM post_tags JOIN posts ON posts.id = post_tags.post_id AND posts.deleted_at IS NULL WHERE post_tags.tag_id = tags.id)"
+
+// tagPaginator 按文章数从多到少
+var tagPaginator = pagination.New("tags").
+	Selecting("tags.*, " + tagPostCount + " AS post_count").
+	OrderBy("post_count DESC, tags.name ASC")
+
+type TagLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewTagLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TagLogic {
+	return &TagLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Suggest 按前缀补全标签，只返回有文章的标签，文章多的在前
+func (l *TagLogic) Suggest(req *types.TagSuggestReq) (resp []*types.TagResp, err error) {
+	prefix := normalizeTag(req.Prefix)
+	if prefix == "" {
+		return nil, errorx.ErrInvalidParams
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	var tags []types.Tag
+	if err := l.svcCtx.DB.Model(&types.Tag{}).
+		Select("tags.*, "+tagPostCount+" AS post_count").
+		Where("tags.name LIKE ?", escapeLike(prefix)+"%").
+		Where(tagPostCount + " > 0").
+		Order("post_count DESC, tags.name ASC").
+		Limit(limit).
+		Find(&tags).Error; err != nil {
+		return nil, errorx.ErrSystem
+	}
+
+	resp = make([]*types.TagResp, 0, len(tags))
+	for i := range tags {
+		resp = append(resp, types.NewTagResp(&tags[i]))
+	}
+	return resp, nil
+}
+
+// Page 所有有文章的标签及其文章数
+func (l *TagLogic) Page(req *types.TagPageReq) (resp *pagination.Page[*types.TagResp], err error) {
+	query := l.svcCtx.DB.Model(&types.Tag{}).Where(tagPostCount + " > 0")
+	page, err := pagination.Find[types.Tag](query, req.Params, tagPaginator)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return pagination.Map(page, types.NewTagResp), nil
+}
+
+// Posts 带有某个标签的文章，不需要登录
+func (l *TagLogic) Posts(req *types.TagPostsReq) (resp *pagination.Page[*types.PostPublicResp], err error) {
+	name := normalizeTag(req.Name)
+	if name == "" {
+		return nil, errorx.ErrInvalidParams
+	}
+
+	query := l.svcCtx.DB.Model(&types.Post{}).Where(
+		"EXISTS (SELECT 1 FROM post_tags JOIN tags ON tags.id = post_tags.tag_id WHERE post_tags.post_id = posts.id AND tags.name = ?)", name)
+	return NewPostLogic(l.ctx, l.svcCtx).publicPage(query, req.Params, req.Sort)
+}
+
+// normalizeTag 标签名统一为小写，去掉首尾空白，中间的空白合并为一个 "-"，"Go" 和 "go" 是同一个标签
+func normalizeTag(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), "-"))
+}
+
+// normalizeTags 规范化并去重，忽略空标签
+func normalizeTags(names []string) ([]string, error) {
+	seen := make(map[string]bool, len(names))
+	tags := make([]string, 0, len(names))
+	for _, name := range names {
+		tag := normalizeTag(name)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, errorx.ErrInvalidParams
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxPostTags {
+		return nil, errorx.ErrInvalidParams
+	}
+	return tags, nil
+}
+
+// setPostTags 把文章的标签替换为 names，不存在的标签自动创建，names 需要已经规范化
+func setPostTags(tx *gorm.DB, post *types.Post, names []string) error {
+	var tags []types.Tag
+	if len(names) > 0 {
+		missing := make([]types.Tag, 0, len(names))
+		for _, name := range names {
+			missing = append(missing, types.Tag{Name: name})
+		}
+		// 并发创建同名标签时由唯一索引去重
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&missing).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("name IN ?", names).Find(&tags).Error; err != nil {
+			return err
+		}
+	}
+
+	post.Tags = tags
+	if len(tags) == 0 {
+		return tx.Model(post).Association("Tags").Clear()
+	}
+	return tx.Model(post).Association("Tags").Replace(tags)
+}
+
+// escapeLike 转义 LIKE 中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,247 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/task4/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 未配置时 access token 和 refresh token 的有效期
+const (
+	defaultTokenExpiry        = 15 * 60
+	defaultRefreshTokenExpiry = 30 * 24 * time.Hour
+)
+
+// TokenLogic 签发、刷新和吊销登录 token
+// access token 有效期短，不保存在服务端，吊销时记录 jti；refresh token 只保存哈希，每次刷新都换成新的
+type TokenLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TokenLogic {
+	return &TokenLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// Issue 登录成功后签发一对新的 token，开始一个新的会话
+func (l *TokenLogic) Issue(user *types.User) (*types.UserLoginResp, error) {
+	familyID, err := auth.NewTokenID()
+	if err != nil {
+		return nil, errorx.ErrSystem
+	}
+	return l.issue(l.svcCtx.DB, user, familyID)
+}
+
+// Refresh 用 refresh token 换一对新的 token，旧的 refresh token 随即失效
+// 已经换过的 refresh token 再次出现说明它可能被盗用，吊销它所在会话的全部 token
+// 并发刷新同一个 token 时只有一个请求成功，其余返回 ErrRefreshConflict，不当作重复使用
+func (l *TokenLogic) Refresh(req *types.TokenRefreshReq) (resp *types.UserLoginResp, err error) {
+	if req.RefreshToken == "" {
+		return nil, errorx.ErrInvalidParams
+	}
+
+	var token types.RefreshToken
+	if err := l.svcCtx.DB.Where("token_hash = ?", auth.HashRefreshToken(req.RefreshToken)).First(&token).Error; err != nil {
+		return nil, errorx.ErrInvalidCredentials
+	}
+
+	now := time.Now()
+	if token.RevokedAt != nil || !token.ExpiresAt.After(now) {
+		return nil, errorx.ErrInvalidCredentials
+	}
+	if token.UsedAt != nil {
+		l.reused(&token)
+		return nil, errorx.ErrInvalidCredentials
+	}
+
+	var user types.User
+	if err := l.svcCtx.DB.Where("id = ?", token.UserID).First(&user).Error; err != nil {
+		return nil, errorx.ErrInvalidCredentials
+	}
+
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		// 带条件更新，并发刷新同一个 token 时只有一个请求能成功
+		res := tx.Model(&types.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", token.ID).
+			UpdateColumn("used_at", now)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return l.refreshLost(tx, token.ID)
+		}
+
+		resp, err = l.issue(tx, &user, token.FamilyID)
+		return err
+	})
+	if err != nil {
+		var codeErr *errorx.CodeError
+		if errors.As(err, &codeErr) {
+			return nil, codeErr
+		}
+		return nil, errorx.ErrSystem
+	}
+
+	return resp, nil
+}
+
+// Logout 退出当前会话，吊销当前的 access token 和同一会话的 refresh token
+// 没有 jti 的旧 token 不属于任何会话，无法吊销，只能等它过期
+func (l *TokenLogic) Logout(claims *auth.Claims) error {
+	if claims.ID == "" {
+		return nil
+	}
+	err := l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if err := revokeAccessToken(tx, claims.ID, claims.UserID, claims.ExpiresAt.Time); err != nil {
+			return err
+		}
+
+		var token types.RefreshToken
+		err := tx.Where("access_jti = ? AND user_id = ?", claims.ID, claims.UserID).First(&token).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return revokeSessions(tx, "family_id = ?", token.FamilyID)
+	})
+	if err != nil {
+		return errorx.ErrSystem
+	}
+	return nil
+}
+
+// LogoutAll 退出用户的所有会话
+func (l *TokenLogic) LogoutAll(userID uint) error {
+	err := l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		return revokeSessions(tx, "user_id = ?", userID)
+	})
+	if err != nil {
+		return errorx.ErrSystem
+	}
+	return nil
+}
+
+// IsRevoked access token 是否已被吊销，供 AuthMiddleware 使用
+func (l *TokenLogic) IsRevoked(jti string) (bool, error) {
+	var n int64
+	if err := l.svcCtx.DB.Model(&types.RevokedToken{}).Where("jti = ?", jti).Count(&n).Error; err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Cleanup 删除已经过期的 refresh token、吊销记录和钱包登录 nonce，过期后它们本身已经无法使用
+func (l *TokenLogic) Cleanup(now time.Time) (int64, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	var n int64
+	for _, model := range []interface{}{&types.RefreshToken{}, &types.RevokedToken{}, &types.SiweNonce{}} {
+		res := db.Where("expires_at < ?", now).Delete(model)
+		if res.Error != nil {
+			return n, res.Error
+		}
+		n += res.RowsAffected
+	}
+	return n, nil
+}
+
+// issue 在 familyID 会话中签发一对新的 token
+func (l *TokenLogic) issue(db *gorm.DB, user *types.User, familyID string) (*types.UserLoginResp, error) {
+	expiry := l.svcCtx.Config.Auth.TokenExpiry
+	if expiry <= 0 {
+		expiry = defaultTokenExpiry
+	}
+	refreshExpiry := l.svcCtx.Config.Auth.RefreshTokenExpiry
+	if refreshExpiry <= 0 {
+		refreshExpiry = defaultRefreshTokenExpiry
+	}
+
+	accessToken, claims, err := auth.IssueToken(user.ID, user.Role, l.svcCtx.Config.Auth.JwtSecret, expiry)
+	if err != nil {
+		return nil, errorx.ErrSystem
+	}
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, errorx.ErrSystem
+	}
+
+	if err := db.Create(&types.RefreshToken{
+		UserID:          user.ID,
+		FamilyID:        familyID,
+		TokenHash:       hash,
+		ExpiresAt:       time.Now().Add(refreshExpiry),
+		AccessJTI:       claims.ID,
+		AccessExpiresAt: claims.ExpiresAt.Time,
+	}).Error; err != nil {
+		return nil, errorx.ErrSystem
+	}
+
+	return &types.UserLoginResp{
+		Token:        accessToken,
+		ExpiresIn:    expiry,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// refreshLost 读取 token 时还没有使用，更新时条件不再满足：被同时退出登录时认证失败，
+// 否则是另一个请求刚刚用它刷新过，返回可以重试的错误
+func (l *TokenLogic) refreshLost(tx *gorm.DB, id uint) error {
+	var current types.RefreshToken
+	if err := tx.Select("revoked_at").Where("id = ?", id).First(&current).Error; err != nil {
+		return err
+	}
+	if current.RevokedAt != nil {
+		return errorx.ErrInvalidCredentials
+	}
+	return errorx.ErrRefreshConflict
+}
+
+// reused 检测到 refresh token 被重复使用，吊销整个会话
+func (l *TokenLogic) reused(token *types.RefreshToken) {
+	l.Infof("refresh token 被重复使用, 吊销会话: user=%d family=%s", token.UserID, token.FamilyID)
+	err := l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		return revokeSessions(tx, "family_id = ?", token.FamilyID)
+	})
+	if err != nil {
+		l.Errorf("吊销会话失败: %v", err)
+	}
+}
+
+// revokeSessions 吊销满足条件的 refresh token，以及和它们一起签发、还没过期的 access token
+func revokeSessions(tx *gorm.DB, query string, args ...interface{}) error {
+	now := time.Now()
+	var tokens []types.RefreshToken
+	if err := tx.Where(query, args...).Where("access_expires_at > ?", now).Find(&tokens).Error; err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err := revokeAccessToken(tx, token.AccessJTI, token.UserID, token.AccessExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Model(&types.RefreshToken{}).Where(query, args...).Where("revoked_at IS NULL").UpdateColumn("revoked_at", now).Error
+}
+
+func revokeAccessToken(tx *gorm.DB, jti string, userID uint, expiresAt time.Time) error {
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&types.RevokedToken{
+		JTI:       jti,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}).Error
+}
@@ -0,0 +1,108 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	errorx "task4-go-zero/internal/error"
+	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/search"
+	"task4-go-zero/task4/api/internal/config"
+	"task4-go-zero/task4/api/internal/svc"
+)
+
+// newMockSvc 使用 sqlmock 作为数据库的 ServiceContext
+func newMockSvc(t *testing.T) (*svc.ServiceContext, sqlmock.Sqlmock) {
+	t.Helper()
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &svc.ServiceContext{
+		Config: config.Config{Auth: config.AuthConfig{JwtSecret: "test-secret"}},
+		DB:     db,
+		Search: search.NewMemoryIndex(),
+	}, mock
+}
+
+// expectRefreshToken 期望按哈希读取 refresh token，usedAt 为 nil 表示还没有用过
+func expectRefreshToken(mock sqlmock.Sqlmock, refreshToken string, usedAt *time.Time) {
+	mock.ExpectQuery("SELECT \\* FROM `refresh_tokens` WHERE token_hash = \\?").
+		WithArgs(auth.HashRefreshToken(refreshToken), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "family_id", "token_hash", "expires_at", "used_at", "revoked_at"}).
+			AddRow(7, 1, "family", auth.HashRefreshToken(refreshToken), time.Now().Add(time.Hour), usedAt, nil))
+}
+
+func TestRefreshConcurrent(t *testing.T) {
+	tests := []struct {
+		name      string
+		revokedAt interface{}
+		want      error
+	}{
+		// 另一个请求刚刚用同一个 token 刷新过，可以重试，不吊销会话
+		{"并发刷新", nil, errorx.ErrRefreshConflict},
+		// 刷新的同时退出登录
+		{"并发退出登录", time.Now(), errorx.ErrInvalidCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, mock := newMockSvc(t)
+			expectRefreshToken(mock, "token", nil)
+			mock.ExpectQuery("SELECT \\* FROM `users`").WillReturnRows(sqlmock.NewRows([]string{"id", "role"}).AddRow(1, auth.RoleUser))
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE `refresh_tokens` SET `used_at`=\\? WHERE id = \\? AND used_at IS NULL AND revoked_at IS NULL").
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery("SELECT `revoked_at` FROM `refresh_tokens` WHERE id = \\?").
+				WillReturnRows(sqlmock.NewRows([]string{"revoked_at"}).AddRow(tt.revokedAt))
+			mock.ExpectRollback()
+			// 吊销会话会开始新的事务，这个预期不应被用到
+			mock.ExpectBegin()
+
+			_, err := NewTokenLogic(context.Background(), svcCtx).Refresh(&types.TokenRefreshReq{RefreshToken: "token"})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Refresh error = %v, want %v", err, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err == nil || !strings.Contains(err.Error(), "Begin") {
+				t.Fatalf("并发刷新不应吊销会话: %v", err)
+			}
+		})
+	}
+}
+
+func TestRefreshReused(t *testing.T) {
+	svcCtx, mock := newMockSvc(t)
+	usedAt := time.Now().Add(-time.Minute)
+	expectRefreshToken(mock, "token", &usedAt)
+	// 请求之前已经用过，吊销整个会话
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT \\* FROM `refresh_tokens` WHERE family_id = \\? AND access_expires_at > \\?").
+		WithArgs("family", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "access_jti", "access_expires_at"}).AddRow(8, 1, "jti", time.Now().Add(time.Minute)))
+	mock.ExpectExec("INSERT INTO `revoked_tokens`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE `refresh_tokens` SET `revoked_at`=\\? WHERE family_id = \\? AND revoked_at IS NULL").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	_, err := NewTokenLogic(context.Background(), svcCtx).Refresh(&types.TokenRefreshReq{RefreshToken: "token"})
+	if !errors.Is(err, errorx.ErrInvalidCredentials) {
+		t.Fatalf("Refresh error = %v, want ErrInvalidCredentials", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	errorx "task4-go-zero/internal/error"
 	"task4-go-zero/internal/types"
 	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/pagination"
 	"task4-go-zero/task4/api/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -42,7 +43,7 @@ func (l *UserLogic) Register(req *types.UserRegisterReq) error {
 		Username: req.Username,
 		Password: string(hashedPassword),
 		Email:    req.Email,
-		Role:     "user",
+		Role:     auth.RoleUser,
 	}
 
 	if err := l.svcCtx.DB.Create(&user).Error; err != nil {
@@ -64,50 +65,67 @@ func (l *UserLogic) Login(req *types.UserLoginReq) (resp *types.UserLoginResp, e
 		return nil, errorx.ErrInvalidCredentials
 	}
 
-	// 生成token
-	token, err := auth.GenerateToken(user.ID, user.Role, l.svcCtx.Config.Auth.JwtSecret, l.svcCtx.Config.Auth.TokenExpiry)
+	// 生成 access token 和 refresh token
+	return NewTokenLogic(l.ctx, l.svcCtx).Issue(&user)
+}
+
+func (l *UserLogic) Page(req *types.UserPageReq, viewerID uint, viewerRole string) (resp *pagination.Page[*types.UserResp], err error) {
+	page, err := pagination.Find[types.User](l.svcCtx.DB.Model(&types.User{}), req.Params, userPaginator)
 	if err != nil {
-		return nil, errorx.ErrSystem
+		return nil, pageError(err)
 	}
 
-	return &types.UserLoginResp{
-		Token: token,
-	}, nil
+	// 按查看者的角色决定每个用户可见的字段
+	return pagination.Map(page, func(u *types.User) *types.UserResp {
+		return types.NewUserResp(u, types.VisibilityFor(viewerID, viewerRole, u.ID))
+	}), nil
+}
+
+// Me 查询当前登录用户的资料
+func (l *UserLogic) Me(userID uint) (*types.UserResp, error) {
+	var user types.User
+	if err := l.svcCtx.DB.Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, errorx.ErrUserNotFound
+	}
+	return types.NewUserResp(&user, types.VisibilityOwner), nil
 }
 
-func (l *UserLogic) Page(req *types.UserPageReq) (resp interface{}, err error) {
-	var users []types.User
-	var total int64
+// Role 查询用户当前角色
+func (l *UserLogic) Role(userID uint) (string, error) {
+	var user types.User
+	if err := l.svcCtx.DB.Select("role").Where("id = ?", userID).First(&user).Error; err != nil {
+		return "", errorx.ErrUserNotFound
+	}
+	return user.Role, nil
+}
 
-	// 查询总数
-	if err := l.svcCtx.DB.Model(&types.User{}).Count(&total).Error; err != nil {
-		return nil, errorx.ErrSystem
+// SetRole 修改用户角色，不能移除最后一个管理员
+func (l *UserLogic) SetRole(req *types.UserRoleReq) error {
+	if !auth.ValidRole(req.Role) {
+		return errorx.ErrInvalidParams
 	}
 
-	// 分页查询
-	page := req.Page
-	pageSize := req.PageSize
-	if page <= 0 {
-		page = 1
+	var user types.User
+	if err := l.svcCtx.DB.Where("id = ?", req.UserID).First(&user).Error; err != nil {
+		return errorx.ErrUserNotFound
 	}
-	if pageSize <= 0 {
-		pageSize = 10
+	if user.Role == req.Role {
+		return nil
 	}
 
-	offset := (page - 1) * pageSize
-	if err := l.svcCtx.DB.Offset(offset).Limit(pageSize).Find(&users).Error; err != nil {
-		return nil, errorx.ErrSystem
+	if user.Role == auth.RoleAdmin {
+		var admins int64
+		if err := l.svcCtx.DB.Model(&types.User{}).Where("role = ?", auth.RoleAdmin).Count(&admins).Error; err != nil {
+			return errorx.ErrSystem
+		}
+		if admins <= 1 {
+			return errorx.ErrInvalidParams
+		}
 	}
 
-	// 计算总页数
-	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
-
-	return map[string]interface{}{
-		"total":       total,
-		"page":        page,
-		"pageSize":    pageSize,
-		"totalPages":  totalPages,
-		"hasNextPage": page < totalPages,
-		"data":        users,
-	}, nil
+	if err := l.svcCtx.DB.Model(&user).Update("role", req.Role).Error; err != nil {
+		return errorx.ErrSystem
+	}
+
+	return nil
 }
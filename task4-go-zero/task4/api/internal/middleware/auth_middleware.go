@@ -10,13 +10,25 @@ import (
 	"task4-go-zero/pkg/auth"
 )
 
+// RoleLoader 按用户ID读取当前角色，修改角色后不需要重新登录即可生效
+type RoleLoader func(ctx context.Context, userID uint) (string, error)
+
+// RevocationChecker 按 jti 检查 access token 是否已被吊销
+type RevocationChecker func(ctx context.Context, jti string) (bool, error)
+
 type AuthMiddleware struct {
 	JwtSecret string
+	// 为 nil 时使用 token 中的角色
+	LoadRole RoleLoader
+	// 为 nil 时不检查吊销
+	IsRevoked RevocationChecker
 }
 
-func NewAuthMiddleware(jwtSecret string) *AuthMiddleware {
+func NewAuthMiddleware(jwtSecret string, loadRole RoleLoader, isRevoked RevocationChecker) *AuthMiddleware {
 	return &AuthMiddleware{
 		JwtSecret: jwtSecret,
+		LoadRole:  loadRole,
+		IsRevoked: isRevoked,
 	}
 }
 
@@ -42,23 +54,34 @@ func (m *AuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 			return
 		}
 
-		// 将用户信息存入请求上下文
-		r = r.WithContext(
-			context.WithValue(
-				r.Context(),
-				"userID",
-				claims.UserID,
-			),
-		)
-		r = r.WithContext(
-			context.WithValue(
-				r.Context(),
-				"userRole",
-				claims.Role,
-			),
-		)
-
-		next(w, r)
+		// 加入吊销之前签发的 token 没有 jti，无法吊销，在过期(签发后 24 小时)之前仍然接受
+		// 退出登录对这些 token 不生效，没有过期时间的 token 不接受
+		if claims.ID == "" {
+			if claims.ExpiresAt == nil {
+				httpx.Error(w, errorx.ErrInvalidCredentials)
+				return
+			}
+		} else if m.IsRevoked != nil {
+			revoked, err := m.IsRevoked(r.Context(), claims.ID)
+			if err != nil || revoked {
+				httpx.Error(w, errorx.ErrInvalidCredentials)
+				return
+			}
+		}
+
+		role := claims.Role
+		if m.LoadRole != nil {
+			if role, err = m.LoadRole(r.Context(), claims.UserID); err != nil {
+				httpx.Error(w, errorx.ErrInvalidCredentials)
+				return
+			}
+		}
+
+		// 将用户信息存入请求上下文，键必须使用 contextKey 类型，和 handler 中读取时一致
+		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+		ctx = context.WithValue(ctx, UserRoleKey, role)
+		ctx = context.WithValue(ctx, TokenClaimsKey, claims)
+		next(w, r.WithContext(ctx))
 	}
 }
 
@@ -67,4 +90,18 @@ type contextKey string
 const (
 	UserIDKey   contextKey = "userID"
 	UserRoleKey contextKey = "userRole"
+	// 当前请求的 access token，退出登录时使用
+	TokenClaimsKey contextKey = "tokenClaims"
 )
+
+// TokenClaims 读取 AuthMiddleware 存入的 token claims
+func TokenClaims(ctx context.Context) *auth.Claims {
+	claims, _ := ctx.Value(TokenClaimsKey).(*auth.Claims)
+	return claims
+}
+
+// UserRole 读取 AuthMiddleware 存入的角色
+func UserRole(ctx context.Context) string {
+	role, _ := ctx.Value(UserRoleKey).(string)
+	return role
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+	"task4-go-zero/pkg/auth"
+)
+
+const testSecret = "test-secret"
+
+// legacyToken 加入吊销之前签发的 token，没有 jti
+func legacyToken(t *testing.T, expiresAt *jwt.NumericDate) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+		UserID:           1,
+		Role:             auth.RoleUser,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: expiresAt},
+	}).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAuthMiddlewareJti(t *testing.T) {
+	logx.Disable()
+	current, err := auth.GenerateToken(1, auth.RoleUser, testSecret, 900)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		token   string
+		revoked bool
+		ok      bool
+		checked bool
+	}{
+		{"有 jti", current, false, true, true},
+		{"已吊销", current, true, false, true},
+		// 没有 jti 的旧 token 在过期前接受，不检查吊销
+		{"没有 jti", legacyToken(t, jwt.NewNumericDate(time.Now().Add(time.Hour))), false, true, false},
+		{"没有 jti 已过期", legacyToken(t, jwt.NewNumericDate(time.Now().Add(-time.Hour))), false, false, false},
+		{"没有 jti 也没有过期时间", legacyToken(t, nil), false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checked := false
+			m := NewAuthMiddleware(testSecret, nil, func(ctx context.Context, jti string) (bool, error) {
+				checked = true
+				return tt.revoked, nil
+			})
+			called := false
+			handler := m.Handle(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if id, _ := r.Context().Value(UserIDKey).(uint); id != 1 {
+					t.Errorf("userID = %d, want 1", id)
+				}
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			handler(httptest.NewRecorder(), req)
+			if called != tt.ok || checked != tt.checked {
+				t.Fatalf("accepted = %v, revocation checked = %v, want %v, %v", called, checked, tt.ok, tt.checked)
+			}
+		})
+	}
+}
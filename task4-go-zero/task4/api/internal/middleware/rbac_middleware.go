@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+	errorx "task4-go-zero/internal/error"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"task4-go-zero/pkg/auth"
+)
+
+// RBACMiddleware 按路由策略检查角色权限，必须放在 AuthMiddleware 之后
+type RBACMiddleware struct {
+	// 键为 "方法 路径"，如 "POST /api/v1/post/create"
+	Policies map[string]auth.Permission
+}
+
+func NewRBACMiddleware(policies map[string]auth.Permission) *RBACMiddleware {
+	return &RBACMiddleware{
+		Policies: policies,
+	}
+}
+
+func (m *RBACMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 没有配置策略的路由一律拒绝，避免新增路由时漏配权限
+		perm, ok := m.Policies[r.Method+" "+r.URL.Path]
+		if !ok || !auth.Can(UserRole(r.Context()), perm) {
+			httpx.Error(w, errorx.ErrUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -1,15 +1,23 @@
 package svc
 
 import (
+	"context"
+
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"task4-go-zero/internal/types"
+	"task4-go-zero/pkg/auth"
+	"task4-go-zero/pkg/search"
 	"task4-go-zero/task4/api/internal/config"
 )
 
+// searchLoadBatch 建立进程内索引时每次从数据库读取的条数
+const searchLoadBatch = 500
+
 type ServiceContext struct {
 	Config config.Config
 	DB     *gorm.DB
+	Search search.Searcher
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -20,10 +28,60 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	}
 
 	// 自动迁移数据模型
-	db.AutoMigrate(&types.User{}, &types.Post{}, &types.Comment{})
+	db.AutoMigrate(&types.User{}, &types.Post{}, &types.Comment{}, &types.Tag{}, &types.Category{}, &types.RefreshToken{}, &types.RevokedToken{}, &types.SiweNonce{})
+
+	// 把配置中的已有用户设为管理员，用于创建第一个管理员
+	if len(c.Auth.AdminUserIDs) > 0 {
+		if err := db.Model(&types.User{}).Where("id IN ?", c.Auth.AdminUserIDs).Update("role", auth.RoleAdmin).Error; err != nil {
+			panic("failed to bootstrap admins: " + err.Error())
+		}
+	}
+
+	// 初始化搜索
+	searcher, err := newSearcher(c.Search, db)
+	if err != nil {
+		panic("failed to init search: " + err.Error())
+	}
 
 	return &ServiceContext{
 		Config: c,
 		DB:     db,
+		Search: searcher,
 	}
 }
+
+func newSearcher(c config.SearchConfig, db *gorm.DB) (search.Searcher, error) {
+	ctx := context.Background()
+	if c.Backend == "mysql" {
+		s := search.NewMySQL(db)
+		return s, s.EnsureIndexes(ctx)
+	}
+
+	// 进程内索引不持久化，启动时加载所有未删除的文章和评论
+	idx := search.NewMemoryIndex()
+	var posts []types.Post
+	err := db.Preload("Tags").FindInBatches(&posts, searchLoadBatch, func(tx *gorm.DB, batch int) error {
+		docs := make([]search.Document, 0, len(posts))
+		for i := range posts {
+			docs = append(docs, posts[i].SearchDocument())
+		}
+		return idx.Index(ctx, docs...)
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []types.Comment
+	err = db.FindInBatches(&comments, searchLoadBatch, func(tx *gorm.DB, batch int) error {
+		docs := make([]search.Document, 0, len(comments))
+		for i := range comments {
+			docs = append(docs, comments[i].SearchDocument())
+		}
+		return idx.Index(ctx, docs...)
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return idx, nil
+}
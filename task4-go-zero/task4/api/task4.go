@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"task4-go-zero/task4/api/internal/config"
 	"task4-go-zero/task4/api/internal/handler"
+	"task4-go-zero/task4/api/internal/logic"
 	"task4-go-zero/task4/api/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/threading"
 	"github.com/zeromicro/go-zero/rest"
 )
 
@@ -29,6 +32,11 @@ func main() {
 	// 注册路由
 	handler.RegisterHandlers(server, serverCtx)
 
+	// 定期清理回收站
+	purgeCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	threading.GoSafe(logic.NewPurgeLogic(purgeCtx, serverCtx).Run)
+
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
 }